@@ -0,0 +1,7 @@
+package internal
+
+// nfs3.go holds NFSv3 and the protocols around it: MOUNT, NFSACL, NLM and
+// NSM. It shares the helper types for opaque[8] and opaque[16] with nfs4.go,
+// so the copies goxdr emits are dropped. Procedure types are exported, as in
+// nfs4.go.
+//go:generate sh -c "goxdr -b -p internal -o nfs3.go nfs3.x mount.x nlm.x sm_inter.x nfs_acl.x && sed -i -e '/^type _XdrArray_\\(8\\|16\\)_opaque /,/^}$/d' -e '/^func (_XdrArray_\\(8\\|16\\)_opaque) XdrArraySize/,/^}$/d' -e 's/\\bxdrProc_/XdrProc_/g' nfs3.go"
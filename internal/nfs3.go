@@ -0,0 +1,9947 @@
+// Code generated by goxdr -b -p internal -o nfs3.go nfs3.x mount.x nlm.x sm_inter.x nfs_acl.x; DO NOT EDIT.
+
+package internal
+import "fmt"
+import "context"
+
+var _ = fmt.Sprintf
+var _ context.Context
+
+//
+// Data types defined in XDR file
+//
+
+/*
+ * Sizes
+ */
+const NFS3_FHSIZE = 64
+
+const NFS3_COOKIEVERFSIZE = 8
+
+const NFS3_CREATEVERFSIZE = 8
+
+const NFS3_WRITEVERFSIZE = 8
+
+/*
+ * Basic data types
+ */
+type Filename3 = string
+
+type Nfspath3 = string
+
+type Fileid3 = uint64
+
+type Cookie3 = uint64
+
+type Cookieverf3 = [NFS3_COOKIEVERFSIZE]byte
+
+type Createverf3 = [NFS3_CREATEVERFSIZE]byte
+
+type Writeverf3 = [NFS3_WRITEVERFSIZE]byte
+
+type Uid3 = uint32
+
+type Gid3 = uint32
+
+type Size3 = uint64
+
+type Offset3 = uint64
+
+type Mode3 = uint32
+
+type Count3 = uint32
+
+type Nfsstat3 int32
+const (
+	NFS3_OK Nfsstat3 = 0
+	NFS3ERR_PERM Nfsstat3 = 1
+	NFS3ERR_NOENT Nfsstat3 = 2
+	NFS3ERR_IO Nfsstat3 = 5
+	NFS3ERR_NXIO Nfsstat3 = 6
+	NFS3ERR_ACCES Nfsstat3 = 13
+	NFS3ERR_EXIST Nfsstat3 = 17
+	NFS3ERR_XDEV Nfsstat3 = 18
+	NFS3ERR_NODEV Nfsstat3 = 19
+	NFS3ERR_NOTDIR Nfsstat3 = 20
+	NFS3ERR_ISDIR Nfsstat3 = 21
+	NFS3ERR_INVAL Nfsstat3 = 22
+	NFS3ERR_FBIG Nfsstat3 = 27
+	NFS3ERR_NOSPC Nfsstat3 = 28
+	NFS3ERR_ROFS Nfsstat3 = 30
+	NFS3ERR_MLINK Nfsstat3 = 31
+	NFS3ERR_NAMETOOLONG Nfsstat3 = 63
+	NFS3ERR_NOTEMPTY Nfsstat3 = 66
+	NFS3ERR_DQUOT Nfsstat3 = 69
+	NFS3ERR_STALE Nfsstat3 = 70
+	NFS3ERR_REMOTE Nfsstat3 = 71
+	NFS3ERR_BADHANDLE Nfsstat3 = 10001
+	NFS3ERR_NOT_SYNC Nfsstat3 = 10002
+	NFS3ERR_BAD_COOKIE Nfsstat3 = 10003
+	NFS3ERR_NOTSUPP Nfsstat3 = 10004
+	NFS3ERR_TOOSMALL Nfsstat3 = 10005
+	NFS3ERR_SERVERFAULT Nfsstat3 = 10006
+	NFS3ERR_BADTYPE Nfsstat3 = 10007
+	NFS3ERR_JUKEBOX Nfsstat3 = 10008
+)
+
+/*
+ * File types
+ */
+type Ftype3 int32
+const (
+	/* Regular File */
+	NF3REG Ftype3 = 1
+	/* Directory */
+	NF3DIR Ftype3 = 2
+	/* Special File - block device */
+	NF3BLK Ftype3 = 3
+	/* Special File - character device */
+	NF3CHR Ftype3 = 4
+	/* Symbolic Link */
+	NF3LNK Ftype3 = 5
+	/* Special File - socket */
+	NF3SOCK Ftype3 = 6
+	/* Special File - fifo */
+	NF3FIFO Ftype3 = 7
+)
+
+type Specdata3 struct {
+	Specdata1 uint32
+	Specdata2 uint32
+}
+
+type Nfs_fh3 struct {
+	Data []byte // bound NFS3_FHSIZE
+}
+
+type Nfstime3 struct {
+	Seconds uint32
+	Nseconds uint32
+}
+
+/*
+ * Attributes
+ */
+type Fattr3 struct {
+	Type Ftype3
+	Mode Mode3
+	Nlink uint32
+	Uid Uid3
+	Gid Gid3
+	Size Size3
+	Used Size3
+	Rdev Specdata3
+	Fsid uint64
+	Fileid Fileid3
+	Atime Nfstime3
+	Mtime Nfstime3
+	Ctime Nfstime3
+}
+
+type Post_op_attr struct {
+	// The union discriminant Attributes_follow selects among the following arms:
+	//   true:
+	//      Attributes() *Fattr3
+	//   false:
+	//      void
+	Attributes_follow bool
+	_u interface{}
+}
+
+type Wcc_attr struct {
+	Size Size3
+	Mtime Nfstime3
+	Ctime Nfstime3
+}
+
+type Pre_op_attr struct {
+	// The union discriminant Attributes_follow selects among the following arms:
+	//   true:
+	//      Attributes() *Wcc_attr
+	//   false:
+	//      void
+	Attributes_follow bool
+	_u interface{}
+}
+
+type Wcc_data struct {
+	Before Pre_op_attr
+	After Post_op_attr
+}
+
+type Post_op_fh3 struct {
+	// The union discriminant Handle_follows selects among the following arms:
+	//   true:
+	//      Handle() *Nfs_fh3
+	//   false:
+	//      void
+	Handle_follows bool
+	_u interface{}
+}
+
+type Time_how int32
+const (
+	DONT_CHANGE Time_how = 0
+	SET_TO_SERVER_TIME Time_how = 1
+	SET_TO_CLIENT_TIME Time_how = 2
+)
+
+type Set_mode3 struct {
+	// The union discriminant Set_it selects among the following arms:
+	//   true:
+	//      Mode() *Mode3
+	//   default:
+	//      void
+	Set_it bool
+	_u interface{}
+}
+
+type Set_uid3 struct {
+	// The union discriminant Set_it selects among the following arms:
+	//   true:
+	//      Uid() *Uid3
+	//   default:
+	//      void
+	Set_it bool
+	_u interface{}
+}
+
+type Set_gid3 struct {
+	// The union discriminant Set_it selects among the following arms:
+	//   true:
+	//      Gid() *Gid3
+	//   default:
+	//      void
+	Set_it bool
+	_u interface{}
+}
+
+type Set_size3 struct {
+	// The union discriminant Set_it selects among the following arms:
+	//   true:
+	//      Size() *Size3
+	//   default:
+	//      void
+	Set_it bool
+	_u interface{}
+}
+
+type Set_atime struct {
+	// The union discriminant Set_it selects among the following arms:
+	//   SET_TO_CLIENT_TIME:
+	//      Atime() *Nfstime3
+	//   default:
+	//      void
+	Set_it Time_how
+	_u interface{}
+}
+
+type Set_mtime struct {
+	// The union discriminant Set_it selects among the following arms:
+	//   SET_TO_CLIENT_TIME:
+	//      Mtime() *Nfstime3
+	//   default:
+	//      void
+	Set_it Time_how
+	_u interface{}
+}
+
+type Sattr3 struct {
+	Mode Set_mode3
+	Uid Set_uid3
+	Gid Set_gid3
+	Size Set_size3
+	Atime Set_atime
+	Mtime Set_mtime
+}
+
+type Diropargs3 struct {
+	Dir Nfs_fh3
+	Name Filename3
+}
+
+/*
+ * GETATTR: Get file attributes
+ */
+type GETATTR3args struct {
+	Object Nfs_fh3
+}
+
+type GETATTR3resok struct {
+	Obj_attributes Fattr3
+}
+
+type GETATTR3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *GETATTR3resok
+	//   default:
+	//      void
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * SETATTR: Set file attributes
+ */
+type Sattrguard3 struct {
+	// The union discriminant Check selects among the following arms:
+	//   true:
+	//      Obj_ctime() *Nfstime3
+	//   false:
+	//      void
+	Check bool
+	_u interface{}
+}
+
+type SETATTR3args struct {
+	Object Nfs_fh3
+	New_attributes Sattr3
+	Guard Sattrguard3
+}
+
+type SETATTR3resok struct {
+	Obj_wcc Wcc_data
+}
+
+type SETATTR3resfail struct {
+	Obj_wcc Wcc_data
+}
+
+type SETATTR3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *SETATTR3resok
+	//   default:
+	//      Resfail() *SETATTR3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * LOOKUP: Lookup filename
+ */
+type LOOKUP3args struct {
+	What Diropargs3
+}
+
+type LOOKUP3resok struct {
+	Object Nfs_fh3
+	Obj_attributes Post_op_attr
+	Dir_attributes Post_op_attr
+}
+
+type LOOKUP3resfail struct {
+	Dir_attributes Post_op_attr
+}
+
+type LOOKUP3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *LOOKUP3resok
+	//   default:
+	//      Resfail() *LOOKUP3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * ACCESS: Check access permission
+ */
+const ACCESS3_READ = 0x0001
+
+const ACCESS3_LOOKUP = 0x0002
+
+const ACCESS3_MODIFY = 0x0004
+
+const ACCESS3_EXTEND = 0x0008
+
+const ACCESS3_DELETE = 0x0010
+
+const ACCESS3_EXECUTE = 0x0020
+
+type ACCESS3args struct {
+	Object Nfs_fh3
+	Access uint32
+}
+
+type ACCESS3resok struct {
+	Obj_attributes Post_op_attr
+	Access uint32
+}
+
+type ACCESS3resfail struct {
+	Obj_attributes Post_op_attr
+}
+
+type ACCESS3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *ACCESS3resok
+	//   default:
+	//      Resfail() *ACCESS3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * READLINK: Read from symbolic link
+ */
+type READLINK3args struct {
+	Symlink Nfs_fh3
+}
+
+type READLINK3resok struct {
+	Symlink_attributes Post_op_attr
+	Data Nfspath3
+}
+
+type READLINK3resfail struct {
+	Symlink_attributes Post_op_attr
+}
+
+type READLINK3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *READLINK3resok
+	//   default:
+	//      Resfail() *READLINK3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * READ: Read from file
+ */
+type READ3args struct {
+	File Nfs_fh3
+	Offset Offset3
+	Count Count3
+}
+
+type READ3resok struct {
+	File_attributes Post_op_attr
+	Count Count3
+	Eof bool
+	Data []byte
+}
+
+type READ3resfail struct {
+	File_attributes Post_op_attr
+}
+
+type READ3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *READ3resok
+	//   default:
+	//      Resfail() *READ3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * WRITE: Write to file
+ */
+type Stable_how int32
+const (
+	UNSTABLE Stable_how = 0
+	DATA_SYNC Stable_how = 1
+	FILE_SYNC Stable_how = 2
+)
+
+type WRITE3args struct {
+	File Nfs_fh3
+	Offset Offset3
+	Count Count3
+	Stable Stable_how
+	Data []byte
+}
+
+type WRITE3resok struct {
+	File_wcc Wcc_data
+	Count Count3
+	Committed Stable_how
+	Verf Writeverf3
+}
+
+type WRITE3resfail struct {
+	File_wcc Wcc_data
+}
+
+type WRITE3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *WRITE3resok
+	//   default:
+	//      Resfail() *WRITE3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * CREATE: Create a file
+ */
+type Createmode3 int32
+const (
+	UNCHECKED Createmode3 = 0
+	GUARDED Createmode3 = 1
+	EXCLUSIVE Createmode3 = 2
+)
+
+type Createhow3 struct {
+	// The union discriminant Mode selects among the following arms:
+	//   UNCHECKED, GUARDED:
+	//      Obj_attributes() *Sattr3
+	//   EXCLUSIVE:
+	//      Verf() *Createverf3
+	Mode Createmode3
+	_u interface{}
+}
+
+type CREATE3args struct {
+	Where Diropargs3
+	How Createhow3
+}
+
+type CREATE3resok struct {
+	Obj Post_op_fh3
+	Obj_attributes Post_op_attr
+	Dir_wcc Wcc_data
+}
+
+type CREATE3resfail struct {
+	Dir_wcc Wcc_data
+}
+
+type CREATE3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *CREATE3resok
+	//   default:
+	//      Resfail() *CREATE3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * MKDIR: Create a directory
+ */
+type MKDIR3args struct {
+	Where Diropargs3
+	Attributes Sattr3
+}
+
+type MKDIR3resok struct {
+	Obj Post_op_fh3
+	Obj_attributes Post_op_attr
+	Dir_wcc Wcc_data
+}
+
+type MKDIR3resfail struct {
+	Dir_wcc Wcc_data
+}
+
+type MKDIR3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *MKDIR3resok
+	//   default:
+	//      Resfail() *MKDIR3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * SYMLINK: Create a symbolic link
+ */
+type Symlinkdata3 struct {
+	Symlink_attributes Sattr3
+	Symlink_data Nfspath3
+}
+
+type SYMLINK3args struct {
+	Where Diropargs3
+	Symlink Symlinkdata3
+}
+
+type SYMLINK3resok struct {
+	Obj Post_op_fh3
+	Obj_attributes Post_op_attr
+	Dir_wcc Wcc_data
+}
+
+type SYMLINK3resfail struct {
+	Dir_wcc Wcc_data
+}
+
+type SYMLINK3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *SYMLINK3resok
+	//   default:
+	//      Resfail() *SYMLINK3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * MKNOD: Create a special device
+ */
+type Devicedata3 struct {
+	Dev_attributes Sattr3
+	Spec Specdata3
+}
+
+type Mknoddata3 struct {
+	// The union discriminant Type selects among the following arms:
+	//   NF3CHR, NF3BLK:
+	//      Device() *Devicedata3
+	//   NF3SOCK, NF3FIFO:
+	//      Pipe_attributes() *Sattr3
+	//   default:
+	//      void
+	Type Ftype3
+	_u interface{}
+}
+
+type MKNOD3args struct {
+	Where Diropargs3
+	What Mknoddata3
+}
+
+type MKNOD3resok struct {
+	Obj Post_op_fh3
+	Obj_attributes Post_op_attr
+	Dir_wcc Wcc_data
+}
+
+type MKNOD3resfail struct {
+	Dir_wcc Wcc_data
+}
+
+type MKNOD3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *MKNOD3resok
+	//   default:
+	//      Resfail() *MKNOD3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * REMOVE: Remove a File
+ */
+type REMOVE3args struct {
+	Object Diropargs3
+}
+
+type REMOVE3resok struct {
+	Dir_wcc Wcc_data
+}
+
+type REMOVE3resfail struct {
+	Dir_wcc Wcc_data
+}
+
+type REMOVE3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *REMOVE3resok
+	//   default:
+	//      Resfail() *REMOVE3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * RMDIR: Remove a Directory
+ */
+type RMDIR3args struct {
+	Object Diropargs3
+}
+
+type RMDIR3resok struct {
+	Dir_wcc Wcc_data
+}
+
+type RMDIR3resfail struct {
+	Dir_wcc Wcc_data
+}
+
+type RMDIR3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *RMDIR3resok
+	//   default:
+	//      Resfail() *RMDIR3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * RENAME: Rename a File or Directory
+ */
+type RENAME3args struct {
+	From Diropargs3
+	To Diropargs3
+}
+
+type RENAME3resok struct {
+	Fromdir_wcc Wcc_data
+	Todir_wcc Wcc_data
+}
+
+type RENAME3resfail struct {
+	Fromdir_wcc Wcc_data
+	Todir_wcc Wcc_data
+}
+
+type RENAME3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *RENAME3resok
+	//   default:
+	//      Resfail() *RENAME3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * LINK: Create Link to an object
+ */
+type LINK3args struct {
+	File Nfs_fh3
+	Link Diropargs3
+}
+
+type LINK3resok struct {
+	File_attributes Post_op_attr
+	Linkdir_wcc Wcc_data
+}
+
+type LINK3resfail struct {
+	File_attributes Post_op_attr
+	Linkdir_wcc Wcc_data
+}
+
+type LINK3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *LINK3resok
+	//   default:
+	//      Resfail() *LINK3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * READDIR: Read From Directory
+ */
+type READDIR3args struct {
+	Dir Nfs_fh3
+	Cookie Cookie3
+	Cookieverf Cookieverf3
+	Count Count3
+}
+
+type Entry3 struct {
+	Fileid Fileid3
+	Name Filename3
+	Cookie Cookie3
+	Nextentry *Entry3
+}
+
+type Dirlist3 struct {
+	Entries *Entry3
+	Eof bool
+}
+
+type READDIR3resok struct {
+	Dir_attributes Post_op_attr
+	Cookieverf Cookieverf3
+	Reply Dirlist3
+}
+
+type READDIR3resfail struct {
+	Dir_attributes Post_op_attr
+}
+
+type READDIR3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *READDIR3resok
+	//   default:
+	//      Resfail() *READDIR3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * READDIRPLUS: Extended read from directory
+ */
+type READDIRPLUS3args struct {
+	Dir Nfs_fh3
+	Cookie Cookie3
+	Cookieverf Cookieverf3
+	Dircount Count3
+	Maxcount Count3
+}
+
+type Entryplus3 struct {
+	Fileid Fileid3
+	Name Filename3
+	Cookie Cookie3
+	Name_attributes Post_op_attr
+	Name_handle Post_op_fh3
+	Nextentry *Entryplus3
+}
+
+type Dirlistplus3 struct {
+	Entries *Entryplus3
+	Eof bool
+}
+
+type READDIRPLUS3resok struct {
+	Dir_attributes Post_op_attr
+	Cookieverf Cookieverf3
+	Reply Dirlistplus3
+}
+
+type READDIRPLUS3resfail struct {
+	Dir_attributes Post_op_attr
+}
+
+type READDIRPLUS3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *READDIRPLUS3resok
+	//   default:
+	//      Resfail() *READDIRPLUS3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * FSSTAT: Get dynamic file system information
+ */
+type FSSTAT3args struct {
+	Fsroot Nfs_fh3
+}
+
+type FSSTAT3resok struct {
+	Obj_attributes Post_op_attr
+	Tbytes Size3
+	Fbytes Size3
+	Abytes Size3
+	Tfiles Size3
+	Ffiles Size3
+	Afiles Size3
+	Invarsec uint32
+}
+
+type FSSTAT3resfail struct {
+	Obj_attributes Post_op_attr
+}
+
+type FSSTAT3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *FSSTAT3resok
+	//   default:
+	//      Resfail() *FSSTAT3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * FSINFO: Get static file system Information
+ */
+const FSF3_LINK = 0x0001
+
+const FSF3_SYMLINK = 0x0002
+
+const FSF3_HOMOGENEOUS = 0x0008
+
+const FSF3_CANSETTIME = 0x0010
+
+type FSINFO3args struct {
+	Fsroot Nfs_fh3
+}
+
+type FSINFO3resok struct {
+	Obj_attributes Post_op_attr
+	Rtmax uint32
+	Rtpref uint32
+	Rtmult uint32
+	Wtmax uint32
+	Wtpref uint32
+	Wtmult uint32
+	Dtpref uint32
+	Maxfilesize Size3
+	Time_delta Nfstime3
+	Properties uint32
+}
+
+type FSINFO3resfail struct {
+	Obj_attributes Post_op_attr
+}
+
+type FSINFO3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *FSINFO3resok
+	//   default:
+	//      Resfail() *FSINFO3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * PATHCONF: Retrieve POSIX information
+ */
+type PATHCONF3args struct {
+	Object Nfs_fh3
+}
+
+type PATHCONF3resok struct {
+	Obj_attributes Post_op_attr
+	Linkmax uint32
+	Name_max uint32
+	No_trunc bool
+	Chown_restricted bool
+	Case_insensitive bool
+	Case_preserving bool
+}
+
+type PATHCONF3resfail struct {
+	Obj_attributes Post_op_attr
+}
+
+type PATHCONF3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *PATHCONF3resok
+	//   default:
+	//      Resfail() *PATHCONF3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+/*
+ * COMMIT: Commit cached data on a server to stable storage
+ */
+type COMMIT3args struct {
+	File Nfs_fh3
+	Offset Offset3
+	Count Count3
+}
+
+type COMMIT3resok struct {
+	File_wcc Wcc_data
+	Verf Writeverf3
+}
+
+type COMMIT3resfail struct {
+	File_wcc Wcc_data
+}
+
+type COMMIT3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *COMMIT3resok
+	//   default:
+	//      Resfail() *COMMIT3resfail
+	Status Nfsstat3
+	_u interface{}
+}
+
+type NFS_V3 interface {
+	NFSPROC3_NULL()
+	NFSPROC3_GETATTR(GETATTR3args) GETATTR3res
+	NFSPROC3_SETATTR(SETATTR3args) SETATTR3res
+	NFSPROC3_LOOKUP(LOOKUP3args) LOOKUP3res
+	NFSPROC3_ACCESS(ACCESS3args) ACCESS3res
+	NFSPROC3_READLINK(READLINK3args) READLINK3res
+	NFSPROC3_READ(READ3args) READ3res
+	NFSPROC3_WRITE(WRITE3args) WRITE3res
+	NFSPROC3_CREATE(CREATE3args) CREATE3res
+	NFSPROC3_MKDIR(MKDIR3args) MKDIR3res
+	NFSPROC3_SYMLINK(SYMLINK3args) SYMLINK3res
+	NFSPROC3_MKNOD(MKNOD3args) MKNOD3res
+	NFSPROC3_REMOVE(REMOVE3args) REMOVE3res
+	NFSPROC3_RMDIR(RMDIR3args) RMDIR3res
+	NFSPROC3_RENAME(RENAME3args) RENAME3res
+	NFSPROC3_LINK(LINK3args) LINK3res
+	NFSPROC3_READDIR(READDIR3args) READDIR3res
+	NFSPROC3_READDIRPLUS(READDIRPLUS3args) READDIRPLUS3res
+	NFSPROC3_FSSTAT(FSSTAT3args) FSSTAT3res
+	NFSPROC3_FSINFO(FSINFO3args) FSINFO3res
+	NFSPROC3_PATHCONF(PATHCONF3args) PATHCONF3res
+	NFSPROC3_COMMIT(COMMIT3args) COMMIT3res
+}
+
+/* Maximum bytes in a path name */
+const MNTPATHLEN = 1024
+
+/* Maximum bytes in a name */
+const MNTNAMLEN = 255
+
+/* Maximum bytes in a V3 file handle */
+const FHSIZE3 = 64
+
+type Fhandle3 = []byte // bound FHSIZE3
+
+type Dirpath = string // bound MNTPATHLEN
+
+type Name = string // bound MNTNAMLEN
+
+type Mountstat3 int32
+const (
+	/* no error */
+	MNT3_OK Mountstat3 = 0
+	/* Not owner */
+	MNT3ERR_PERM Mountstat3 = 1
+	/* No such file or directory */
+	MNT3ERR_NOENT Mountstat3 = 2
+	/* I/O error */
+	MNT3ERR_IO Mountstat3 = 5
+	/* Permission denied */
+	MNT3ERR_ACCES Mountstat3 = 13
+	/* Not a directory */
+	MNT3ERR_NOTDIR Mountstat3 = 20
+	/* Invalid argument */
+	MNT3ERR_INVAL Mountstat3 = 22
+	/* Filename too long */
+	MNT3ERR_NAMETOOLONG Mountstat3 = 63
+	/* Operation not supported */
+	MNT3ERR_NOTSUPP Mountstat3 = 10004
+	/* A failure on the server */
+	MNT3ERR_SERVERFAULT Mountstat3 = 10006
+)
+
+type Mountres3_ok struct {
+	Fhandle Fhandle3
+	Auth_flavors []int32
+}
+
+type Mountres3 struct {
+	// The union discriminant Fhs_status selects among the following arms:
+	//   MNT3_OK:
+	//      Mountinfo() *Mountres3_ok
+	//   default:
+	//      void
+	Fhs_status Mountstat3
+	_u interface{}
+}
+
+type Mountlist = *Mountbody
+
+type Mountbody struct {
+	Ml_hostname Name
+	Ml_directory Dirpath
+	Ml_next Mountlist
+}
+
+type Groups = *Groupnode
+
+type Groupnode struct {
+	Gr_name Name
+	Gr_next Groups
+}
+
+type Exports = *Exportnode
+
+type Exportnode struct {
+	Ex_dir Dirpath
+	Ex_groups Groups
+	Ex_next Exports
+}
+
+type MOUNT_V3 interface {
+	MOUNTPROC3_NULL()
+	MOUNTPROC3_MNT(Dirpath) Mountres3
+	MOUNTPROC3_DUMP() Mountlist
+	MOUNTPROC3_UMNT(Dirpath)
+	MOUNTPROC3_UMNTALL()
+	MOUNTPROC3_EXPORT() Exports
+}
+
+const LM_MAXSTRLEN = 1024
+
+const MAXNETOBJ_SZ = 1024
+
+type Netobj = []byte // bound MAXNETOBJ_SZ
+
+type Nlm4_stats int32
+const (
+	NLM4_GRANTED Nlm4_stats = 0
+	NLM4_DENIED Nlm4_stats = 1
+	NLM4_DENIED_NOLOCKS Nlm4_stats = 2
+	NLM4_BLOCKED Nlm4_stats = 3
+	NLM4_DENIED_GRACE_PERIOD Nlm4_stats = 4
+	NLM4_DEADLCK Nlm4_stats = 5
+	NLM4_ROFS Nlm4_stats = 6
+	NLM4_STALE_FH Nlm4_stats = 7
+	NLM4_FBIG Nlm4_stats = 8
+	NLM4_FAILED Nlm4_stats = 9
+)
+
+type Nlm4_holder struct {
+	Exclusive bool
+	Svid int32
+	Oh Netobj
+	L_offset uint64
+	L_len uint64
+}
+
+type Nlm4_testrply struct {
+	// The union discriminant Stat selects among the following arms:
+	//   NLM4_DENIED:
+	//      Holder() *Nlm4_holder
+	//   default:
+	//      void
+	Stat Nlm4_stats
+	_u interface{}
+}
+
+type Nlm4_stat struct {
+	Stat Nlm4_stats
+}
+
+type Nlm4_res struct {
+	Cookie Netobj
+	Stat Nlm4_stat
+}
+
+type Nlm4_testres struct {
+	Cookie Netobj
+	Test_stat Nlm4_testrply
+}
+
+type Nlm4_lock struct {
+	Caller_name string // bound LM_MAXSTRLEN
+	Fh Netobj
+	Oh Netobj
+	Svid int32
+	L_offset uint64
+	L_len uint64
+}
+
+type Nlm4_lockargs struct {
+	Cookie Netobj
+	Block bool
+	Exclusive bool
+	Alock Nlm4_lock
+	Reclaim bool
+	State int32
+}
+
+type Nlm4_cancargs struct {
+	Cookie Netobj
+	Block bool
+	Exclusive bool
+	Alock Nlm4_lock
+}
+
+type Nlm4_testargs struct {
+	Cookie Netobj
+	Exclusive bool
+	Alock Nlm4_lock
+}
+
+type Nlm4_unlockargs struct {
+	Cookie Netobj
+	Alock Nlm4_lock
+}
+
+type Fsh4_mode int32
+const (
+	Fsm_DN Fsh4_mode = 0
+	Fsm_DR Fsh4_mode = 1
+	Fsm_DW Fsh4_mode = 2
+	Fsm_DRW Fsh4_mode = 3
+)
+
+type Fsh4_access int32
+const (
+	Fsa_NONE Fsh4_access = 0
+	Fsa_R Fsh4_access = 1
+	Fsa_W Fsh4_access = 2
+	Fsa_RW Fsh4_access = 3
+)
+
+type Nlm4_share struct {
+	Caller_name string // bound LM_MAXSTRLEN
+	Fh Netobj
+	Oh Netobj
+	Mode Fsh4_mode
+	Access Fsh4_access
+}
+
+type Nlm4_shareargs struct {
+	Cookie Netobj
+	Share Nlm4_share
+	Reclaim bool
+}
+
+type Nlm4_shareres struct {
+	Cookie Netobj
+	Stat Nlm4_stats
+	Sequence int32
+}
+
+type Nlm4_notify struct {
+	Name string // bound LM_MAXSTRLEN
+	State int32
+}
+
+/*
+ * The argument of the status callback registered with NSM by SM_MON, as
+ * used by lockd implementations.
+ */
+type Nlm4_sm_status struct {
+	Mon_name string // bound LM_MAXSTRLEN
+	State int32
+	Priv [16]byte
+}
+
+type NLM4_VERS interface {
+	NLMPROC4_NULL()
+	NLMPROC4_TEST(Nlm4_testargs) Nlm4_testres
+	NLMPROC4_LOCK(Nlm4_lockargs) Nlm4_res
+	NLMPROC4_CANCEL(Nlm4_cancargs) Nlm4_res
+	NLMPROC4_UNLOCK(Nlm4_unlockargs) Nlm4_res
+	NLMPROC4_GRANTED(Nlm4_testargs) Nlm4_res
+	NLMPROC4_TEST_MSG(Nlm4_testargs)
+	NLMPROC4_LOCK_MSG(Nlm4_lockargs)
+	NLMPROC4_CANCEL_MSG(Nlm4_cancargs)
+	NLMPROC4_UNLOCK_MSG(Nlm4_unlockargs)
+	NLMPROC4_GRANTED_MSG(Nlm4_testargs)
+	NLMPROC4_TEST_RES(Nlm4_testres)
+	NLMPROC4_LOCK_RES(Nlm4_res)
+	NLMPROC4_CANCEL_RES(Nlm4_res)
+	NLMPROC4_UNLOCK_RES(Nlm4_res)
+	NLMPROC4_GRANTED_RES(Nlm4_res)
+	NLMPROC4_SM_NOTIFY(Nlm4_sm_status)
+	NLMPROC4_SHARE(Nlm4_shareargs) Nlm4_shareres
+	NLMPROC4_UNSHARE(Nlm4_shareargs) Nlm4_shareres
+	NLMPROC4_NM_LOCK(Nlm4_lockargs) Nlm4_res
+	NLMPROC4_FREE_ALL(Nlm4_notify)
+}
+
+const SM_MAXSTRLEN = 1024
+
+type Sm_name struct {
+	Mon_name string // bound SM_MAXSTRLEN
+}
+
+type Sm_res int32
+const (
+	/* NSM agrees to monitor */
+	Stat_succ Sm_res = 0
+	/* NSM cannot monitor */
+	Stat_fail Sm_res = 1
+)
+
+type Sm_stat_res struct {
+	Res_stat Sm_res
+	State int32
+}
+
+type Sm_stat struct {
+	State int32
+}
+
+type My_id struct {
+	/* name of the host to call back */
+	My_name string // bound SM_MAXSTRLEN
+	/* RPC program to call back */
+	My_prog int32
+	My_vers int32
+	My_proc int32
+}
+
+type Mon_id struct {
+	/* name of the host to monitor */
+	Mon_name string // bound SM_MAXSTRLEN
+	My_id My_id
+}
+
+type Mon struct {
+	Mon_id Mon_id
+	/* passed back in the callback */
+	Priv [16]byte
+}
+
+type Stat_chge struct {
+	Mon_name string // bound SM_MAXSTRLEN
+	State int32
+}
+
+type SM_VERS interface {
+	SM_STAT(Sm_name) Sm_stat_res
+	SM_MON(Mon) Sm_stat_res
+	SM_UNMON(Mon_id) Sm_stat
+	SM_UNMON_ALL(My_id) Sm_stat
+	SM_SIMU_CRASH()
+	SM_NOTIFY(Stat_chge)
+}
+
+const NFS_ACL_MAX_ENTRIES = 1024
+
+/* Bits of the mask in GETACL3args and secattr */
+const NFS_ACL = 0x1
+
+const NFS_ACLCNT = 0x2
+
+const NFS_DFACL = 0x4
+
+const NFS_DFACLCNT = 0x8
+
+/* Set in the type of the entries of a default ACL */
+const NFS_ACL_DEFAULT = 0x1000
+
+type Aclent struct {
+	Type uint32
+	Id uint32
+	Perm uint32
+}
+
+type Secattr struct {
+	Mask uint32
+	Aclcnt int32
+	Aclentp []Aclent // bound NFS_ACL_MAX_ENTRIES
+	Dfaclcnt int32
+	Dfaclentp []Aclent // bound NFS_ACL_MAX_ENTRIES
+}
+
+type GETACL3args struct {
+	Fh Nfs_fh3
+	Mask uint32
+}
+
+type GETACL3resok struct {
+	Attr Post_op_attr
+	Acl Secattr
+}
+
+type GETACL3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *GETACL3resok
+	//   default:
+	//      Resfail() *Post_op_attr
+	Status Nfsstat3
+	_u interface{}
+}
+
+type SETACL3args struct {
+	Fh Nfs_fh3
+	Acl Secattr
+}
+
+type SETACL3resok struct {
+	Attr Post_op_attr
+}
+
+type SETACL3res struct {
+	// The union discriminant Status selects among the following arms:
+	//   NFS3_OK:
+	//      Resok() *SETACL3resok
+	//   default:
+	//      Resfail() *Post_op_attr
+	Status Nfsstat3
+	_u interface{}
+}
+
+type NFS_ACL_V3 interface {
+	ACLPROC3_NULL()
+	ACLPROC3_GETACL(GETACL3args) GETACL3res
+	ACLPROC3_SETACL(SETACL3args) SETACL3res
+}
+
+//
+// Helper types and generated marshaling functions
+//
+
+type XdrType_Filename3 struct {
+	XdrString
+}
+func XDR_Filename3(v *Filename3) XdrType_Filename3 {
+	return XdrType_Filename3{XdrString{v, 0xffffffff}}
+}
+func (XdrType_Filename3) XdrTypeName() string { return "Filename3" }
+func (v XdrType_Filename3) XdrUnwrap() XdrType { return v.XdrString }
+type XdrType_Nfspath3 struct {
+	XdrString
+}
+func XDR_Nfspath3(v *Nfspath3) XdrType_Nfspath3 {
+	return XdrType_Nfspath3{XdrString{v, 0xffffffff}}
+}
+func (XdrType_Nfspath3) XdrTypeName() string { return "Nfspath3" }
+func (v XdrType_Nfspath3) XdrUnwrap() XdrType { return v.XdrString }
+type XdrType_Fileid3 struct {
+	XdrType_uint64
+}
+func XDR_Fileid3(v *Fileid3) XdrType_Fileid3 {
+	return XdrType_Fileid3{XDR_uint64(v)}
+}
+func (XdrType_Fileid3) XdrTypeName() string { return "Fileid3" }
+func (v XdrType_Fileid3) XdrUnwrap() XdrType { return v.XdrType_uint64 }
+type XdrType_Cookie3 struct {
+	XdrType_uint64
+}
+func XDR_Cookie3(v *Cookie3) XdrType_Cookie3 {
+	return XdrType_Cookie3{XDR_uint64(v)}
+}
+func (XdrType_Cookie3) XdrTypeName() string { return "Cookie3" }
+func (v XdrType_Cookie3) XdrUnwrap() XdrType { return v.XdrType_uint64 }
+type XdrType_Cookieverf3 struct {
+	*_XdrArray_8_opaque
+}
+func XDR_Cookieverf3(v *Cookieverf3) XdrType_Cookieverf3 {
+	return XdrType_Cookieverf3{(*_XdrArray_8_opaque)(v)}
+}
+func (XdrType_Cookieverf3) XdrTypeName() string { return "Cookieverf3" }
+func (v XdrType_Cookieverf3) XdrUnwrap() XdrType { return v._XdrArray_8_opaque }
+type XdrType_Createverf3 struct {
+	*_XdrArray_8_opaque
+}
+func XDR_Createverf3(v *Createverf3) XdrType_Createverf3 {
+	return XdrType_Createverf3{(*_XdrArray_8_opaque)(v)}
+}
+func (XdrType_Createverf3) XdrTypeName() string { return "Createverf3" }
+func (v XdrType_Createverf3) XdrUnwrap() XdrType { return v._XdrArray_8_opaque }
+type XdrType_Writeverf3 struct {
+	*_XdrArray_8_opaque
+}
+func XDR_Writeverf3(v *Writeverf3) XdrType_Writeverf3 {
+	return XdrType_Writeverf3{(*_XdrArray_8_opaque)(v)}
+}
+func (XdrType_Writeverf3) XdrTypeName() string { return "Writeverf3" }
+func (v XdrType_Writeverf3) XdrUnwrap() XdrType { return v._XdrArray_8_opaque }
+type XdrType_Uid3 struct {
+	XdrType_uint32
+}
+func XDR_Uid3(v *Uid3) XdrType_Uid3 {
+	return XdrType_Uid3{XDR_uint32(v)}
+}
+func (XdrType_Uid3) XdrTypeName() string { return "Uid3" }
+func (v XdrType_Uid3) XdrUnwrap() XdrType { return v.XdrType_uint32 }
+type XdrType_Gid3 struct {
+	XdrType_uint32
+}
+func XDR_Gid3(v *Gid3) XdrType_Gid3 {
+	return XdrType_Gid3{XDR_uint32(v)}
+}
+func (XdrType_Gid3) XdrTypeName() string { return "Gid3" }
+func (v XdrType_Gid3) XdrUnwrap() XdrType { return v.XdrType_uint32 }
+type XdrType_Size3 struct {
+	XdrType_uint64
+}
+func XDR_Size3(v *Size3) XdrType_Size3 {
+	return XdrType_Size3{XDR_uint64(v)}
+}
+func (XdrType_Size3) XdrTypeName() string { return "Size3" }
+func (v XdrType_Size3) XdrUnwrap() XdrType { return v.XdrType_uint64 }
+type XdrType_Offset3 struct {
+	XdrType_uint64
+}
+func XDR_Offset3(v *Offset3) XdrType_Offset3 {
+	return XdrType_Offset3{XDR_uint64(v)}
+}
+func (XdrType_Offset3) XdrTypeName() string { return "Offset3" }
+func (v XdrType_Offset3) XdrUnwrap() XdrType { return v.XdrType_uint64 }
+type XdrType_Mode3 struct {
+	XdrType_uint32
+}
+func XDR_Mode3(v *Mode3) XdrType_Mode3 {
+	return XdrType_Mode3{XDR_uint32(v)}
+}
+func (XdrType_Mode3) XdrTypeName() string { return "Mode3" }
+func (v XdrType_Mode3) XdrUnwrap() XdrType { return v.XdrType_uint32 }
+type XdrType_Count3 struct {
+	XdrType_uint32
+}
+func XDR_Count3(v *Count3) XdrType_Count3 {
+	return XdrType_Count3{XDR_uint32(v)}
+}
+func (XdrType_Count3) XdrTypeName() string { return "Count3" }
+func (v XdrType_Count3) XdrUnwrap() XdrType { return v.XdrType_uint32 }
+var _XdrNames_Nfsstat3 = map[int32]string{
+	int32(NFS3_OK): "NFS3_OK",
+	int32(NFS3ERR_PERM): "NFS3ERR_PERM",
+	int32(NFS3ERR_NOENT): "NFS3ERR_NOENT",
+	int32(NFS3ERR_IO): "NFS3ERR_IO",
+	int32(NFS3ERR_NXIO): "NFS3ERR_NXIO",
+	int32(NFS3ERR_ACCES): "NFS3ERR_ACCES",
+	int32(NFS3ERR_EXIST): "NFS3ERR_EXIST",
+	int32(NFS3ERR_XDEV): "NFS3ERR_XDEV",
+	int32(NFS3ERR_NODEV): "NFS3ERR_NODEV",
+	int32(NFS3ERR_NOTDIR): "NFS3ERR_NOTDIR",
+	int32(NFS3ERR_ISDIR): "NFS3ERR_ISDIR",
+	int32(NFS3ERR_INVAL): "NFS3ERR_INVAL",
+	int32(NFS3ERR_FBIG): "NFS3ERR_FBIG",
+	int32(NFS3ERR_NOSPC): "NFS3ERR_NOSPC",
+	int32(NFS3ERR_ROFS): "NFS3ERR_ROFS",
+	int32(NFS3ERR_MLINK): "NFS3ERR_MLINK",
+	int32(NFS3ERR_NAMETOOLONG): "NFS3ERR_NAMETOOLONG",
+	int32(NFS3ERR_NOTEMPTY): "NFS3ERR_NOTEMPTY",
+	int32(NFS3ERR_DQUOT): "NFS3ERR_DQUOT",
+	int32(NFS3ERR_STALE): "NFS3ERR_STALE",
+	int32(NFS3ERR_REMOTE): "NFS3ERR_REMOTE",
+	int32(NFS3ERR_BADHANDLE): "NFS3ERR_BADHANDLE",
+	int32(NFS3ERR_NOT_SYNC): "NFS3ERR_NOT_SYNC",
+	int32(NFS3ERR_BAD_COOKIE): "NFS3ERR_BAD_COOKIE",
+	int32(NFS3ERR_NOTSUPP): "NFS3ERR_NOTSUPP",
+	int32(NFS3ERR_TOOSMALL): "NFS3ERR_TOOSMALL",
+	int32(NFS3ERR_SERVERFAULT): "NFS3ERR_SERVERFAULT",
+	int32(NFS3ERR_BADTYPE): "NFS3ERR_BADTYPE",
+	int32(NFS3ERR_JUKEBOX): "NFS3ERR_JUKEBOX",
+}
+var _XdrValues_Nfsstat3 = map[string]int32{
+	"NFS3_OK": int32(NFS3_OK),
+	"NFS3ERR_PERM": int32(NFS3ERR_PERM),
+	"NFS3ERR_NOENT": int32(NFS3ERR_NOENT),
+	"NFS3ERR_IO": int32(NFS3ERR_IO),
+	"NFS3ERR_NXIO": int32(NFS3ERR_NXIO),
+	"NFS3ERR_ACCES": int32(NFS3ERR_ACCES),
+	"NFS3ERR_EXIST": int32(NFS3ERR_EXIST),
+	"NFS3ERR_XDEV": int32(NFS3ERR_XDEV),
+	"NFS3ERR_NODEV": int32(NFS3ERR_NODEV),
+	"NFS3ERR_NOTDIR": int32(NFS3ERR_NOTDIR),
+	"NFS3ERR_ISDIR": int32(NFS3ERR_ISDIR),
+	"NFS3ERR_INVAL": int32(NFS3ERR_INVAL),
+	"NFS3ERR_FBIG": int32(NFS3ERR_FBIG),
+	"NFS3ERR_NOSPC": int32(NFS3ERR_NOSPC),
+	"NFS3ERR_ROFS": int32(NFS3ERR_ROFS),
+	"NFS3ERR_MLINK": int32(NFS3ERR_MLINK),
+	"NFS3ERR_NAMETOOLONG": int32(NFS3ERR_NAMETOOLONG),
+	"NFS3ERR_NOTEMPTY": int32(NFS3ERR_NOTEMPTY),
+	"NFS3ERR_DQUOT": int32(NFS3ERR_DQUOT),
+	"NFS3ERR_STALE": int32(NFS3ERR_STALE),
+	"NFS3ERR_REMOTE": int32(NFS3ERR_REMOTE),
+	"NFS3ERR_BADHANDLE": int32(NFS3ERR_BADHANDLE),
+	"NFS3ERR_NOT_SYNC": int32(NFS3ERR_NOT_SYNC),
+	"NFS3ERR_BAD_COOKIE": int32(NFS3ERR_BAD_COOKIE),
+	"NFS3ERR_NOTSUPP": int32(NFS3ERR_NOTSUPP),
+	"NFS3ERR_TOOSMALL": int32(NFS3ERR_TOOSMALL),
+	"NFS3ERR_SERVERFAULT": int32(NFS3ERR_SERVERFAULT),
+	"NFS3ERR_BADTYPE": int32(NFS3ERR_BADTYPE),
+	"NFS3ERR_JUKEBOX": int32(NFS3ERR_JUKEBOX),
+}
+func (Nfsstat3) XdrEnumNames() map[int32]string {
+	return _XdrNames_Nfsstat3
+}
+func (v Nfsstat3) String() string {
+	if s, ok := _XdrNames_Nfsstat3[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Nfsstat3#%d", v)
+}
+func (v *Nfsstat3) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Nfsstat3[stok]; ok {
+			*v = Nfsstat3(val)
+			return nil
+		} else if stok == "Nfsstat3" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Nfsstat3.", stok))
+	}
+}
+func (v Nfsstat3) GetU32() uint32 { return uint32(v) }
+func (v *Nfsstat3) SetU32(n uint32) { *v = Nfsstat3(n) }
+func (v *Nfsstat3) XdrPointer() interface{} { return v }
+func (Nfsstat3) XdrTypeName() string { return "Nfsstat3" }
+func (v Nfsstat3) XdrValue() interface{} { return v }
+func (v *Nfsstat3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Nfsstat3 = *Nfsstat3
+func XDR_Nfsstat3(v *Nfsstat3) *Nfsstat3 { return v }
+var _XdrNames_Ftype3 = map[int32]string{
+	int32(NF3REG): "NF3REG",
+	int32(NF3DIR): "NF3DIR",
+	int32(NF3BLK): "NF3BLK",
+	int32(NF3CHR): "NF3CHR",
+	int32(NF3LNK): "NF3LNK",
+	int32(NF3SOCK): "NF3SOCK",
+	int32(NF3FIFO): "NF3FIFO",
+}
+var _XdrValues_Ftype3 = map[string]int32{
+	"NF3REG": int32(NF3REG),
+	"NF3DIR": int32(NF3DIR),
+	"NF3BLK": int32(NF3BLK),
+	"NF3CHR": int32(NF3CHR),
+	"NF3LNK": int32(NF3LNK),
+	"NF3SOCK": int32(NF3SOCK),
+	"NF3FIFO": int32(NF3FIFO),
+}
+func (Ftype3) XdrEnumNames() map[int32]string {
+	return _XdrNames_Ftype3
+}
+func (v Ftype3) String() string {
+	if s, ok := _XdrNames_Ftype3[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Ftype3#%d", v)
+}
+func (v *Ftype3) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Ftype3[stok]; ok {
+			*v = Ftype3(val)
+			return nil
+		} else if stok == "Ftype3" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Ftype3.", stok))
+	}
+}
+func (v Ftype3) GetU32() uint32 { return uint32(v) }
+func (v *Ftype3) SetU32(n uint32) { *v = Ftype3(n) }
+func (v *Ftype3) XdrPointer() interface{} { return v }
+func (Ftype3) XdrTypeName() string { return "Ftype3" }
+func (v Ftype3) XdrValue() interface{} { return v }
+func (v *Ftype3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Ftype3 = *Ftype3
+func XDR_Ftype3(v *Ftype3) *Ftype3 { return v }
+func (v *Ftype3) XdrInitialize() {
+	switch Ftype3(0) {
+	case NF3REG, NF3DIR, NF3BLK, NF3CHR, NF3LNK, NF3SOCK, NF3FIFO:
+	default:
+		if *v == Ftype3(0) { *v = NF3REG }
+	}
+}
+type XdrType_Specdata3 = *Specdata3
+func (v *Specdata3) XdrPointer() interface{} { return v }
+func (Specdata3) XdrTypeName() string { return "Specdata3" }
+func (v Specdata3) XdrValue() interface{} { return v }
+func (v *Specdata3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Specdata3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sspecdata1", name), XDR_uint32(&v.Specdata1))
+	x.Marshal(x.Sprintf("%sspecdata2", name), XDR_uint32(&v.Specdata2))
+}
+func XDR_Specdata3(v *Specdata3) *Specdata3 { return v }
+type XdrType_Nfs_fh3 = *Nfs_fh3
+func (v *Nfs_fh3) XdrPointer() interface{} { return v }
+func (Nfs_fh3) XdrTypeName() string { return "Nfs_fh3" }
+func (v Nfs_fh3) XdrValue() interface{} { return v }
+func (v *Nfs_fh3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nfs_fh3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdata", name), XdrVecOpaque{&v.Data, NFS3_FHSIZE})
+}
+func XDR_Nfs_fh3(v *Nfs_fh3) *Nfs_fh3 { return v }
+type XdrType_Nfstime3 = *Nfstime3
+func (v *Nfstime3) XdrPointer() interface{} { return v }
+func (Nfstime3) XdrTypeName() string { return "Nfstime3" }
+func (v Nfstime3) XdrValue() interface{} { return v }
+func (v *Nfstime3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nfstime3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sseconds", name), XDR_uint32(&v.Seconds))
+	x.Marshal(x.Sprintf("%snseconds", name), XDR_uint32(&v.Nseconds))
+}
+func XDR_Nfstime3(v *Nfstime3) *Nfstime3 { return v }
+type XdrType_Fattr3 = *Fattr3
+func (v *Fattr3) XdrPointer() interface{} { return v }
+func (Fattr3) XdrTypeName() string { return "Fattr3" }
+func (v Fattr3) XdrValue() interface{} { return v }
+func (v *Fattr3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Fattr3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%stype", name), XDR_Ftype3(&v.Type))
+	x.Marshal(x.Sprintf("%smode", name), XDR_Mode3(&v.Mode))
+	x.Marshal(x.Sprintf("%snlink", name), XDR_uint32(&v.Nlink))
+	x.Marshal(x.Sprintf("%suid", name), XDR_Uid3(&v.Uid))
+	x.Marshal(x.Sprintf("%sgid", name), XDR_Gid3(&v.Gid))
+	x.Marshal(x.Sprintf("%ssize", name), XDR_Size3(&v.Size))
+	x.Marshal(x.Sprintf("%sused", name), XDR_Size3(&v.Used))
+	x.Marshal(x.Sprintf("%srdev", name), XDR_Specdata3(&v.Rdev))
+	x.Marshal(x.Sprintf("%sfsid", name), XDR_uint64(&v.Fsid))
+	x.Marshal(x.Sprintf("%sfileid", name), XDR_Fileid3(&v.Fileid))
+	x.Marshal(x.Sprintf("%satime", name), XDR_Nfstime3(&v.Atime))
+	x.Marshal(x.Sprintf("%smtime", name), XDR_Nfstime3(&v.Mtime))
+	x.Marshal(x.Sprintf("%sctime", name), XDR_Nfstime3(&v.Ctime))
+}
+func XDR_Fattr3(v *Fattr3) *Fattr3 { return v }
+var _XdrTags_Post_op_attr = map[int32]bool{
+	XdrToI32(true): true,
+	XdrToI32(false): true,
+}
+func (_ Post_op_attr) XdrValidTags() map[int32]bool {
+	return _XdrTags_Post_op_attr
+}
+func (u *Post_op_attr) Attributes() *Fattr3 {
+	switch u.Attributes_follow {
+	case true:
+		if v, ok := u._u.(*Fattr3); ok {
+			return v
+		} else {
+			var zero Fattr3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Post_op_attr.Attributes accessed when Attributes_follow == %v", u.Attributes_follow)
+		return nil
+	}
+}
+func (u Post_op_attr) XdrValid() bool {
+	switch u.Attributes_follow {
+	case true,false:
+		return true
+	}
+	return false
+}
+func (u *Post_op_attr) XdrUnionTag() XdrNum32 {
+	return XDR_bool(&u.Attributes_follow)
+}
+func (u *Post_op_attr) XdrUnionTagName() string {
+	return "Attributes_follow"
+}
+func (u *Post_op_attr) XdrUnionBody() XdrType {
+	switch u.Attributes_follow {
+	case true:
+		return XDR_Fattr3(u.Attributes())
+	case false:
+		return nil
+	}
+	return nil
+}
+func (u *Post_op_attr) XdrUnionBodyName() string {
+	switch u.Attributes_follow {
+	case true:
+		return "Attributes"
+	case false:
+		return ""
+	}
+	return ""
+}
+type XdrType_Post_op_attr = *Post_op_attr
+func (v *Post_op_attr) XdrPointer() interface{} { return v }
+func (Post_op_attr) XdrTypeName() string { return "Post_op_attr" }
+func (v Post_op_attr) XdrValue() interface{} { return v }
+func (v *Post_op_attr) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Post_op_attr) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_bool(&u.Attributes_follow).XdrMarshal(x, x.Sprintf("%sattributes_follow", name))
+	switch u.Attributes_follow {
+	case true:
+		x.Marshal(x.Sprintf("%sattributes", name), XDR_Fattr3(u.Attributes()))
+		return
+	case false:
+		return
+	}
+	XdrPanic("invalid Attributes_follow (%v) in Post_op_attr", u.Attributes_follow)
+}
+func XDR_Post_op_attr(v *Post_op_attr) *Post_op_attr { return v}
+type XdrType_Wcc_attr = *Wcc_attr
+func (v *Wcc_attr) XdrPointer() interface{} { return v }
+func (Wcc_attr) XdrTypeName() string { return "Wcc_attr" }
+func (v Wcc_attr) XdrValue() interface{} { return v }
+func (v *Wcc_attr) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Wcc_attr) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%ssize", name), XDR_Size3(&v.Size))
+	x.Marshal(x.Sprintf("%smtime", name), XDR_Nfstime3(&v.Mtime))
+	x.Marshal(x.Sprintf("%sctime", name), XDR_Nfstime3(&v.Ctime))
+}
+func XDR_Wcc_attr(v *Wcc_attr) *Wcc_attr { return v }
+var _XdrTags_Pre_op_attr = map[int32]bool{
+	XdrToI32(true): true,
+	XdrToI32(false): true,
+}
+func (_ Pre_op_attr) XdrValidTags() map[int32]bool {
+	return _XdrTags_Pre_op_attr
+}
+func (u *Pre_op_attr) Attributes() *Wcc_attr {
+	switch u.Attributes_follow {
+	case true:
+		if v, ok := u._u.(*Wcc_attr); ok {
+			return v
+		} else {
+			var zero Wcc_attr
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Pre_op_attr.Attributes accessed when Attributes_follow == %v", u.Attributes_follow)
+		return nil
+	}
+}
+func (u Pre_op_attr) XdrValid() bool {
+	switch u.Attributes_follow {
+	case true,false:
+		return true
+	}
+	return false
+}
+func (u *Pre_op_attr) XdrUnionTag() XdrNum32 {
+	return XDR_bool(&u.Attributes_follow)
+}
+func (u *Pre_op_attr) XdrUnionTagName() string {
+	return "Attributes_follow"
+}
+func (u *Pre_op_attr) XdrUnionBody() XdrType {
+	switch u.Attributes_follow {
+	case true:
+		return XDR_Wcc_attr(u.Attributes())
+	case false:
+		return nil
+	}
+	return nil
+}
+func (u *Pre_op_attr) XdrUnionBodyName() string {
+	switch u.Attributes_follow {
+	case true:
+		return "Attributes"
+	case false:
+		return ""
+	}
+	return ""
+}
+type XdrType_Pre_op_attr = *Pre_op_attr
+func (v *Pre_op_attr) XdrPointer() interface{} { return v }
+func (Pre_op_attr) XdrTypeName() string { return "Pre_op_attr" }
+func (v Pre_op_attr) XdrValue() interface{} { return v }
+func (v *Pre_op_attr) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Pre_op_attr) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_bool(&u.Attributes_follow).XdrMarshal(x, x.Sprintf("%sattributes_follow", name))
+	switch u.Attributes_follow {
+	case true:
+		x.Marshal(x.Sprintf("%sattributes", name), XDR_Wcc_attr(u.Attributes()))
+		return
+	case false:
+		return
+	}
+	XdrPanic("invalid Attributes_follow (%v) in Pre_op_attr", u.Attributes_follow)
+}
+func XDR_Pre_op_attr(v *Pre_op_attr) *Pre_op_attr { return v}
+type XdrType_Wcc_data = *Wcc_data
+func (v *Wcc_data) XdrPointer() interface{} { return v }
+func (Wcc_data) XdrTypeName() string { return "Wcc_data" }
+func (v Wcc_data) XdrValue() interface{} { return v }
+func (v *Wcc_data) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Wcc_data) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sbefore", name), XDR_Pre_op_attr(&v.Before))
+	x.Marshal(x.Sprintf("%safter", name), XDR_Post_op_attr(&v.After))
+}
+func XDR_Wcc_data(v *Wcc_data) *Wcc_data { return v }
+var _XdrTags_Post_op_fh3 = map[int32]bool{
+	XdrToI32(true): true,
+	XdrToI32(false): true,
+}
+func (_ Post_op_fh3) XdrValidTags() map[int32]bool {
+	return _XdrTags_Post_op_fh3
+}
+func (u *Post_op_fh3) Handle() *Nfs_fh3 {
+	switch u.Handle_follows {
+	case true:
+		if v, ok := u._u.(*Nfs_fh3); ok {
+			return v
+		} else {
+			var zero Nfs_fh3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Post_op_fh3.Handle accessed when Handle_follows == %v", u.Handle_follows)
+		return nil
+	}
+}
+func (u Post_op_fh3) XdrValid() bool {
+	switch u.Handle_follows {
+	case true,false:
+		return true
+	}
+	return false
+}
+func (u *Post_op_fh3) XdrUnionTag() XdrNum32 {
+	return XDR_bool(&u.Handle_follows)
+}
+func (u *Post_op_fh3) XdrUnionTagName() string {
+	return "Handle_follows"
+}
+func (u *Post_op_fh3) XdrUnionBody() XdrType {
+	switch u.Handle_follows {
+	case true:
+		return XDR_Nfs_fh3(u.Handle())
+	case false:
+		return nil
+	}
+	return nil
+}
+func (u *Post_op_fh3) XdrUnionBodyName() string {
+	switch u.Handle_follows {
+	case true:
+		return "Handle"
+	case false:
+		return ""
+	}
+	return ""
+}
+type XdrType_Post_op_fh3 = *Post_op_fh3
+func (v *Post_op_fh3) XdrPointer() interface{} { return v }
+func (Post_op_fh3) XdrTypeName() string { return "Post_op_fh3" }
+func (v Post_op_fh3) XdrValue() interface{} { return v }
+func (v *Post_op_fh3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Post_op_fh3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_bool(&u.Handle_follows).XdrMarshal(x, x.Sprintf("%shandle_follows", name))
+	switch u.Handle_follows {
+	case true:
+		x.Marshal(x.Sprintf("%shandle", name), XDR_Nfs_fh3(u.Handle()))
+		return
+	case false:
+		return
+	}
+	XdrPanic("invalid Handle_follows (%v) in Post_op_fh3", u.Handle_follows)
+}
+func XDR_Post_op_fh3(v *Post_op_fh3) *Post_op_fh3 { return v}
+var _XdrNames_Time_how = map[int32]string{
+	int32(DONT_CHANGE): "DONT_CHANGE",
+	int32(SET_TO_SERVER_TIME): "SET_TO_SERVER_TIME",
+	int32(SET_TO_CLIENT_TIME): "SET_TO_CLIENT_TIME",
+}
+var _XdrValues_Time_how = map[string]int32{
+	"DONT_CHANGE": int32(DONT_CHANGE),
+	"SET_TO_SERVER_TIME": int32(SET_TO_SERVER_TIME),
+	"SET_TO_CLIENT_TIME": int32(SET_TO_CLIENT_TIME),
+}
+func (Time_how) XdrEnumNames() map[int32]string {
+	return _XdrNames_Time_how
+}
+func (v Time_how) String() string {
+	if s, ok := _XdrNames_Time_how[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Time_how#%d", v)
+}
+func (v *Time_how) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Time_how[stok]; ok {
+			*v = Time_how(val)
+			return nil
+		} else if stok == "Time_how" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Time_how.", stok))
+	}
+}
+func (v Time_how) GetU32() uint32 { return uint32(v) }
+func (v *Time_how) SetU32(n uint32) { *v = Time_how(n) }
+func (v *Time_how) XdrPointer() interface{} { return v }
+func (Time_how) XdrTypeName() string { return "Time_how" }
+func (v Time_how) XdrValue() interface{} { return v }
+func (v *Time_how) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Time_how = *Time_how
+func XDR_Time_how(v *Time_how) *Time_how { return v }
+func (_ Set_mode3) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Set_mode3) Mode() *Mode3 {
+	switch u.Set_it {
+	case true:
+		if v, ok := u._u.(*Mode3); ok {
+			return v
+		} else {
+			var zero Mode3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Set_mode3.Mode accessed when Set_it == %v", u.Set_it)
+		return nil
+	}
+}
+func (u Set_mode3) XdrValid() bool {
+	return true
+}
+func (u *Set_mode3) XdrUnionTag() XdrNum32 {
+	return XDR_bool(&u.Set_it)
+}
+func (u *Set_mode3) XdrUnionTagName() string {
+	return "Set_it"
+}
+func (u *Set_mode3) XdrUnionBody() XdrType {
+	switch u.Set_it {
+	case true:
+		return XDR_Mode3(u.Mode())
+	default:
+		return nil
+	}
+}
+func (u *Set_mode3) XdrUnionBodyName() string {
+	switch u.Set_it {
+	case true:
+		return "Mode"
+	default:
+		return ""
+	}
+}
+type XdrType_Set_mode3 = *Set_mode3
+func (v *Set_mode3) XdrPointer() interface{} { return v }
+func (Set_mode3) XdrTypeName() string { return "Set_mode3" }
+func (v Set_mode3) XdrValue() interface{} { return v }
+func (v *Set_mode3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Set_mode3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_bool(&u.Set_it).XdrMarshal(x, x.Sprintf("%sset_it", name))
+	switch u.Set_it {
+	case true:
+		x.Marshal(x.Sprintf("%smode", name), XDR_Mode3(u.Mode()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Set_mode3(v *Set_mode3) *Set_mode3 { return v}
+func (_ Set_uid3) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Set_uid3) Uid() *Uid3 {
+	switch u.Set_it {
+	case true:
+		if v, ok := u._u.(*Uid3); ok {
+			return v
+		} else {
+			var zero Uid3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Set_uid3.Uid accessed when Set_it == %v", u.Set_it)
+		return nil
+	}
+}
+func (u Set_uid3) XdrValid() bool {
+	return true
+}
+func (u *Set_uid3) XdrUnionTag() XdrNum32 {
+	return XDR_bool(&u.Set_it)
+}
+func (u *Set_uid3) XdrUnionTagName() string {
+	return "Set_it"
+}
+func (u *Set_uid3) XdrUnionBody() XdrType {
+	switch u.Set_it {
+	case true:
+		return XDR_Uid3(u.Uid())
+	default:
+		return nil
+	}
+}
+func (u *Set_uid3) XdrUnionBodyName() string {
+	switch u.Set_it {
+	case true:
+		return "Uid"
+	default:
+		return ""
+	}
+}
+type XdrType_Set_uid3 = *Set_uid3
+func (v *Set_uid3) XdrPointer() interface{} { return v }
+func (Set_uid3) XdrTypeName() string { return "Set_uid3" }
+func (v Set_uid3) XdrValue() interface{} { return v }
+func (v *Set_uid3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Set_uid3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_bool(&u.Set_it).XdrMarshal(x, x.Sprintf("%sset_it", name))
+	switch u.Set_it {
+	case true:
+		x.Marshal(x.Sprintf("%suid", name), XDR_Uid3(u.Uid()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Set_uid3(v *Set_uid3) *Set_uid3 { return v}
+func (_ Set_gid3) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Set_gid3) Gid() *Gid3 {
+	switch u.Set_it {
+	case true:
+		if v, ok := u._u.(*Gid3); ok {
+			return v
+		} else {
+			var zero Gid3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Set_gid3.Gid accessed when Set_it == %v", u.Set_it)
+		return nil
+	}
+}
+func (u Set_gid3) XdrValid() bool {
+	return true
+}
+func (u *Set_gid3) XdrUnionTag() XdrNum32 {
+	return XDR_bool(&u.Set_it)
+}
+func (u *Set_gid3) XdrUnionTagName() string {
+	return "Set_it"
+}
+func (u *Set_gid3) XdrUnionBody() XdrType {
+	switch u.Set_it {
+	case true:
+		return XDR_Gid3(u.Gid())
+	default:
+		return nil
+	}
+}
+func (u *Set_gid3) XdrUnionBodyName() string {
+	switch u.Set_it {
+	case true:
+		return "Gid"
+	default:
+		return ""
+	}
+}
+type XdrType_Set_gid3 = *Set_gid3
+func (v *Set_gid3) XdrPointer() interface{} { return v }
+func (Set_gid3) XdrTypeName() string { return "Set_gid3" }
+func (v Set_gid3) XdrValue() interface{} { return v }
+func (v *Set_gid3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Set_gid3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_bool(&u.Set_it).XdrMarshal(x, x.Sprintf("%sset_it", name))
+	switch u.Set_it {
+	case true:
+		x.Marshal(x.Sprintf("%sgid", name), XDR_Gid3(u.Gid()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Set_gid3(v *Set_gid3) *Set_gid3 { return v}
+func (_ Set_size3) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Set_size3) Size() *Size3 {
+	switch u.Set_it {
+	case true:
+		if v, ok := u._u.(*Size3); ok {
+			return v
+		} else {
+			var zero Size3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Set_size3.Size accessed when Set_it == %v", u.Set_it)
+		return nil
+	}
+}
+func (u Set_size3) XdrValid() bool {
+	return true
+}
+func (u *Set_size3) XdrUnionTag() XdrNum32 {
+	return XDR_bool(&u.Set_it)
+}
+func (u *Set_size3) XdrUnionTagName() string {
+	return "Set_it"
+}
+func (u *Set_size3) XdrUnionBody() XdrType {
+	switch u.Set_it {
+	case true:
+		return XDR_Size3(u.Size())
+	default:
+		return nil
+	}
+}
+func (u *Set_size3) XdrUnionBodyName() string {
+	switch u.Set_it {
+	case true:
+		return "Size"
+	default:
+		return ""
+	}
+}
+type XdrType_Set_size3 = *Set_size3
+func (v *Set_size3) XdrPointer() interface{} { return v }
+func (Set_size3) XdrTypeName() string { return "Set_size3" }
+func (v Set_size3) XdrValue() interface{} { return v }
+func (v *Set_size3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Set_size3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_bool(&u.Set_it).XdrMarshal(x, x.Sprintf("%sset_it", name))
+	switch u.Set_it {
+	case true:
+		x.Marshal(x.Sprintf("%ssize", name), XDR_Size3(u.Size()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Set_size3(v *Set_size3) *Set_size3 { return v}
+func (_ Set_atime) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Set_atime) Atime() *Nfstime3 {
+	switch u.Set_it {
+	case SET_TO_CLIENT_TIME:
+		if v, ok := u._u.(*Nfstime3); ok {
+			return v
+		} else {
+			var zero Nfstime3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Set_atime.Atime accessed when Set_it == %v", u.Set_it)
+		return nil
+	}
+}
+func (u Set_atime) XdrValid() bool {
+	return true
+}
+func (u *Set_atime) XdrUnionTag() XdrNum32 {
+	return XDR_Time_how(&u.Set_it)
+}
+func (u *Set_atime) XdrUnionTagName() string {
+	return "Set_it"
+}
+func (u *Set_atime) XdrUnionBody() XdrType {
+	switch u.Set_it {
+	case SET_TO_CLIENT_TIME:
+		return XDR_Nfstime3(u.Atime())
+	default:
+		return nil
+	}
+}
+func (u *Set_atime) XdrUnionBodyName() string {
+	switch u.Set_it {
+	case SET_TO_CLIENT_TIME:
+		return "Atime"
+	default:
+		return ""
+	}
+}
+type XdrType_Set_atime = *Set_atime
+func (v *Set_atime) XdrPointer() interface{} { return v }
+func (Set_atime) XdrTypeName() string { return "Set_atime" }
+func (v Set_atime) XdrValue() interface{} { return v }
+func (v *Set_atime) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Set_atime) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Time_how(&u.Set_it).XdrMarshal(x, x.Sprintf("%sset_it", name))
+	switch u.Set_it {
+	case SET_TO_CLIENT_TIME:
+		x.Marshal(x.Sprintf("%satime", name), XDR_Nfstime3(u.Atime()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Set_atime(v *Set_atime) *Set_atime { return v}
+func (_ Set_mtime) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Set_mtime) Mtime() *Nfstime3 {
+	switch u.Set_it {
+	case SET_TO_CLIENT_TIME:
+		if v, ok := u._u.(*Nfstime3); ok {
+			return v
+		} else {
+			var zero Nfstime3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Set_mtime.Mtime accessed when Set_it == %v", u.Set_it)
+		return nil
+	}
+}
+func (u Set_mtime) XdrValid() bool {
+	return true
+}
+func (u *Set_mtime) XdrUnionTag() XdrNum32 {
+	return XDR_Time_how(&u.Set_it)
+}
+func (u *Set_mtime) XdrUnionTagName() string {
+	return "Set_it"
+}
+func (u *Set_mtime) XdrUnionBody() XdrType {
+	switch u.Set_it {
+	case SET_TO_CLIENT_TIME:
+		return XDR_Nfstime3(u.Mtime())
+	default:
+		return nil
+	}
+}
+func (u *Set_mtime) XdrUnionBodyName() string {
+	switch u.Set_it {
+	case SET_TO_CLIENT_TIME:
+		return "Mtime"
+	default:
+		return ""
+	}
+}
+type XdrType_Set_mtime = *Set_mtime
+func (v *Set_mtime) XdrPointer() interface{} { return v }
+func (Set_mtime) XdrTypeName() string { return "Set_mtime" }
+func (v Set_mtime) XdrValue() interface{} { return v }
+func (v *Set_mtime) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Set_mtime) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Time_how(&u.Set_it).XdrMarshal(x, x.Sprintf("%sset_it", name))
+	switch u.Set_it {
+	case SET_TO_CLIENT_TIME:
+		x.Marshal(x.Sprintf("%smtime", name), XDR_Nfstime3(u.Mtime()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Set_mtime(v *Set_mtime) *Set_mtime { return v}
+type XdrType_Sattr3 = *Sattr3
+func (v *Sattr3) XdrPointer() interface{} { return v }
+func (Sattr3) XdrTypeName() string { return "Sattr3" }
+func (v Sattr3) XdrValue() interface{} { return v }
+func (v *Sattr3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Sattr3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%smode", name), XDR_Set_mode3(&v.Mode))
+	x.Marshal(x.Sprintf("%suid", name), XDR_Set_uid3(&v.Uid))
+	x.Marshal(x.Sprintf("%sgid", name), XDR_Set_gid3(&v.Gid))
+	x.Marshal(x.Sprintf("%ssize", name), XDR_Set_size3(&v.Size))
+	x.Marshal(x.Sprintf("%satime", name), XDR_Set_atime(&v.Atime))
+	x.Marshal(x.Sprintf("%smtime", name), XDR_Set_mtime(&v.Mtime))
+}
+func XDR_Sattr3(v *Sattr3) *Sattr3 { return v }
+type XdrType_Diropargs3 = *Diropargs3
+func (v *Diropargs3) XdrPointer() interface{} { return v }
+func (Diropargs3) XdrTypeName() string { return "Diropargs3" }
+func (v Diropargs3) XdrValue() interface{} { return v }
+func (v *Diropargs3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Diropargs3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir", name), XDR_Nfs_fh3(&v.Dir))
+	x.Marshal(x.Sprintf("%sname", name), XDR_Filename3(&v.Name))
+}
+func XDR_Diropargs3(v *Diropargs3) *Diropargs3 { return v }
+type XdrType_GETATTR3args = *GETATTR3args
+func (v *GETATTR3args) XdrPointer() interface{} { return v }
+func (GETATTR3args) XdrTypeName() string { return "GETATTR3args" }
+func (v GETATTR3args) XdrValue() interface{} { return v }
+func (v *GETATTR3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *GETATTR3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobject", name), XDR_Nfs_fh3(&v.Object))
+}
+func XDR_GETATTR3args(v *GETATTR3args) *GETATTR3args { return v }
+type XdrType_GETATTR3resok = *GETATTR3resok
+func (v *GETATTR3resok) XdrPointer() interface{} { return v }
+func (GETATTR3resok) XdrTypeName() string { return "GETATTR3resok" }
+func (v GETATTR3resok) XdrValue() interface{} { return v }
+func (v *GETATTR3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *GETATTR3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Fattr3(&v.Obj_attributes))
+}
+func XDR_GETATTR3resok(v *GETATTR3resok) *GETATTR3resok { return v }
+func (_ GETATTR3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *GETATTR3res) Resok() *GETATTR3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*GETATTR3resok); ok {
+			return v
+		} else {
+			var zero GETATTR3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("GETATTR3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u GETATTR3res) XdrValid() bool {
+	return true
+}
+func (u *GETATTR3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *GETATTR3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *GETATTR3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_GETATTR3resok(u.Resok())
+	default:
+		return nil
+	}
+}
+func (u *GETATTR3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return ""
+	}
+}
+type XdrType_GETATTR3res = *GETATTR3res
+func (v *GETATTR3res) XdrPointer() interface{} { return v }
+func (GETATTR3res) XdrTypeName() string { return "GETATTR3res" }
+func (v GETATTR3res) XdrValue() interface{} { return v }
+func (v *GETATTR3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *GETATTR3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_GETATTR3resok(u.Resok()))
+		return
+	default:
+		return
+	}
+}
+func XDR_GETATTR3res(v *GETATTR3res) *GETATTR3res { return v}
+var _XdrTags_Sattrguard3 = map[int32]bool{
+	XdrToI32(true): true,
+	XdrToI32(false): true,
+}
+func (_ Sattrguard3) XdrValidTags() map[int32]bool {
+	return _XdrTags_Sattrguard3
+}
+func (u *Sattrguard3) Obj_ctime() *Nfstime3 {
+	switch u.Check {
+	case true:
+		if v, ok := u._u.(*Nfstime3); ok {
+			return v
+		} else {
+			var zero Nfstime3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Sattrguard3.Obj_ctime accessed when Check == %v", u.Check)
+		return nil
+	}
+}
+func (u Sattrguard3) XdrValid() bool {
+	switch u.Check {
+	case true,false:
+		return true
+	}
+	return false
+}
+func (u *Sattrguard3) XdrUnionTag() XdrNum32 {
+	return XDR_bool(&u.Check)
+}
+func (u *Sattrguard3) XdrUnionTagName() string {
+	return "Check"
+}
+func (u *Sattrguard3) XdrUnionBody() XdrType {
+	switch u.Check {
+	case true:
+		return XDR_Nfstime3(u.Obj_ctime())
+	case false:
+		return nil
+	}
+	return nil
+}
+func (u *Sattrguard3) XdrUnionBodyName() string {
+	switch u.Check {
+	case true:
+		return "Obj_ctime"
+	case false:
+		return ""
+	}
+	return ""
+}
+type XdrType_Sattrguard3 = *Sattrguard3
+func (v *Sattrguard3) XdrPointer() interface{} { return v }
+func (Sattrguard3) XdrTypeName() string { return "Sattrguard3" }
+func (v Sattrguard3) XdrValue() interface{} { return v }
+func (v *Sattrguard3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Sattrguard3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_bool(&u.Check).XdrMarshal(x, x.Sprintf("%scheck", name))
+	switch u.Check {
+	case true:
+		x.Marshal(x.Sprintf("%sobj_ctime", name), XDR_Nfstime3(u.Obj_ctime()))
+		return
+	case false:
+		return
+	}
+	XdrPanic("invalid Check (%v) in Sattrguard3", u.Check)
+}
+func XDR_Sattrguard3(v *Sattrguard3) *Sattrguard3 { return v}
+type XdrType_SETATTR3args = *SETATTR3args
+func (v *SETATTR3args) XdrPointer() interface{} { return v }
+func (SETATTR3args) XdrTypeName() string { return "SETATTR3args" }
+func (v SETATTR3args) XdrValue() interface{} { return v }
+func (v *SETATTR3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *SETATTR3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobject", name), XDR_Nfs_fh3(&v.Object))
+	x.Marshal(x.Sprintf("%snew_attributes", name), XDR_Sattr3(&v.New_attributes))
+	x.Marshal(x.Sprintf("%sguard", name), XDR_Sattrguard3(&v.Guard))
+}
+func XDR_SETATTR3args(v *SETATTR3args) *SETATTR3args { return v }
+type XdrType_SETATTR3resok = *SETATTR3resok
+func (v *SETATTR3resok) XdrPointer() interface{} { return v }
+func (SETATTR3resok) XdrTypeName() string { return "SETATTR3resok" }
+func (v SETATTR3resok) XdrValue() interface{} { return v }
+func (v *SETATTR3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *SETATTR3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_wcc", name), XDR_Wcc_data(&v.Obj_wcc))
+}
+func XDR_SETATTR3resok(v *SETATTR3resok) *SETATTR3resok { return v }
+type XdrType_SETATTR3resfail = *SETATTR3resfail
+func (v *SETATTR3resfail) XdrPointer() interface{} { return v }
+func (SETATTR3resfail) XdrTypeName() string { return "SETATTR3resfail" }
+func (v SETATTR3resfail) XdrValue() interface{} { return v }
+func (v *SETATTR3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *SETATTR3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_wcc", name), XDR_Wcc_data(&v.Obj_wcc))
+}
+func XDR_SETATTR3resfail(v *SETATTR3resfail) *SETATTR3resfail { return v }
+func (_ SETATTR3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *SETATTR3res) Resok() *SETATTR3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*SETATTR3resok); ok {
+			return v
+		} else {
+			var zero SETATTR3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("SETATTR3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *SETATTR3res) Resfail() *SETATTR3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("SETATTR3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*SETATTR3resfail); ok {
+			return v
+		} else {
+			var zero SETATTR3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u SETATTR3res) XdrValid() bool {
+	return true
+}
+func (u *SETATTR3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *SETATTR3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *SETATTR3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_SETATTR3resok(u.Resok())
+	default:
+		return XDR_SETATTR3resfail(u.Resfail())
+	}
+}
+func (u *SETATTR3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_SETATTR3res = *SETATTR3res
+func (v *SETATTR3res) XdrPointer() interface{} { return v }
+func (SETATTR3res) XdrTypeName() string { return "SETATTR3res" }
+func (v SETATTR3res) XdrValue() interface{} { return v }
+func (v *SETATTR3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *SETATTR3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_SETATTR3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_SETATTR3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_SETATTR3res(v *SETATTR3res) *SETATTR3res { return v}
+type XdrType_LOOKUP3args = *LOOKUP3args
+func (v *LOOKUP3args) XdrPointer() interface{} { return v }
+func (LOOKUP3args) XdrTypeName() string { return "LOOKUP3args" }
+func (v LOOKUP3args) XdrValue() interface{} { return v }
+func (v *LOOKUP3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *LOOKUP3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%swhat", name), XDR_Diropargs3(&v.What))
+}
+func XDR_LOOKUP3args(v *LOOKUP3args) *LOOKUP3args { return v }
+type XdrType_LOOKUP3resok = *LOOKUP3resok
+func (v *LOOKUP3resok) XdrPointer() interface{} { return v }
+func (LOOKUP3resok) XdrTypeName() string { return "LOOKUP3resok" }
+func (v LOOKUP3resok) XdrValue() interface{} { return v }
+func (v *LOOKUP3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *LOOKUP3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobject", name), XDR_Nfs_fh3(&v.Object))
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%sdir_attributes", name), XDR_Post_op_attr(&v.Dir_attributes))
+}
+func XDR_LOOKUP3resok(v *LOOKUP3resok) *LOOKUP3resok { return v }
+type XdrType_LOOKUP3resfail = *LOOKUP3resfail
+func (v *LOOKUP3resfail) XdrPointer() interface{} { return v }
+func (LOOKUP3resfail) XdrTypeName() string { return "LOOKUP3resfail" }
+func (v LOOKUP3resfail) XdrValue() interface{} { return v }
+func (v *LOOKUP3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *LOOKUP3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_attributes", name), XDR_Post_op_attr(&v.Dir_attributes))
+}
+func XDR_LOOKUP3resfail(v *LOOKUP3resfail) *LOOKUP3resfail { return v }
+func (_ LOOKUP3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *LOOKUP3res) Resok() *LOOKUP3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*LOOKUP3resok); ok {
+			return v
+		} else {
+			var zero LOOKUP3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("LOOKUP3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *LOOKUP3res) Resfail() *LOOKUP3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("LOOKUP3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*LOOKUP3resfail); ok {
+			return v
+		} else {
+			var zero LOOKUP3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u LOOKUP3res) XdrValid() bool {
+	return true
+}
+func (u *LOOKUP3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *LOOKUP3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *LOOKUP3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_LOOKUP3resok(u.Resok())
+	default:
+		return XDR_LOOKUP3resfail(u.Resfail())
+	}
+}
+func (u *LOOKUP3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_LOOKUP3res = *LOOKUP3res
+func (v *LOOKUP3res) XdrPointer() interface{} { return v }
+func (LOOKUP3res) XdrTypeName() string { return "LOOKUP3res" }
+func (v LOOKUP3res) XdrValue() interface{} { return v }
+func (v *LOOKUP3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *LOOKUP3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_LOOKUP3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_LOOKUP3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_LOOKUP3res(v *LOOKUP3res) *LOOKUP3res { return v}
+type XdrType_ACCESS3args = *ACCESS3args
+func (v *ACCESS3args) XdrPointer() interface{} { return v }
+func (ACCESS3args) XdrTypeName() string { return "ACCESS3args" }
+func (v ACCESS3args) XdrValue() interface{} { return v }
+func (v *ACCESS3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *ACCESS3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobject", name), XDR_Nfs_fh3(&v.Object))
+	x.Marshal(x.Sprintf("%saccess", name), XDR_uint32(&v.Access))
+}
+func XDR_ACCESS3args(v *ACCESS3args) *ACCESS3args { return v }
+type XdrType_ACCESS3resok = *ACCESS3resok
+func (v *ACCESS3resok) XdrPointer() interface{} { return v }
+func (ACCESS3resok) XdrTypeName() string { return "ACCESS3resok" }
+func (v ACCESS3resok) XdrValue() interface{} { return v }
+func (v *ACCESS3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *ACCESS3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%saccess", name), XDR_uint32(&v.Access))
+}
+func XDR_ACCESS3resok(v *ACCESS3resok) *ACCESS3resok { return v }
+type XdrType_ACCESS3resfail = *ACCESS3resfail
+func (v *ACCESS3resfail) XdrPointer() interface{} { return v }
+func (ACCESS3resfail) XdrTypeName() string { return "ACCESS3resfail" }
+func (v ACCESS3resfail) XdrValue() interface{} { return v }
+func (v *ACCESS3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *ACCESS3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+}
+func XDR_ACCESS3resfail(v *ACCESS3resfail) *ACCESS3resfail { return v }
+func (_ ACCESS3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *ACCESS3res) Resok() *ACCESS3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*ACCESS3resok); ok {
+			return v
+		} else {
+			var zero ACCESS3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("ACCESS3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *ACCESS3res) Resfail() *ACCESS3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("ACCESS3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*ACCESS3resfail); ok {
+			return v
+		} else {
+			var zero ACCESS3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u ACCESS3res) XdrValid() bool {
+	return true
+}
+func (u *ACCESS3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *ACCESS3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *ACCESS3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_ACCESS3resok(u.Resok())
+	default:
+		return XDR_ACCESS3resfail(u.Resfail())
+	}
+}
+func (u *ACCESS3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_ACCESS3res = *ACCESS3res
+func (v *ACCESS3res) XdrPointer() interface{} { return v }
+func (ACCESS3res) XdrTypeName() string { return "ACCESS3res" }
+func (v ACCESS3res) XdrValue() interface{} { return v }
+func (v *ACCESS3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *ACCESS3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_ACCESS3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_ACCESS3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_ACCESS3res(v *ACCESS3res) *ACCESS3res { return v}
+type XdrType_READLINK3args = *READLINK3args
+func (v *READLINK3args) XdrPointer() interface{} { return v }
+func (READLINK3args) XdrTypeName() string { return "READLINK3args" }
+func (v READLINK3args) XdrValue() interface{} { return v }
+func (v *READLINK3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READLINK3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%ssymlink", name), XDR_Nfs_fh3(&v.Symlink))
+}
+func XDR_READLINK3args(v *READLINK3args) *READLINK3args { return v }
+type XdrType_READLINK3resok = *READLINK3resok
+func (v *READLINK3resok) XdrPointer() interface{} { return v }
+func (READLINK3resok) XdrTypeName() string { return "READLINK3resok" }
+func (v READLINK3resok) XdrValue() interface{} { return v }
+func (v *READLINK3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READLINK3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%ssymlink_attributes", name), XDR_Post_op_attr(&v.Symlink_attributes))
+	x.Marshal(x.Sprintf("%sdata", name), XDR_Nfspath3(&v.Data))
+}
+func XDR_READLINK3resok(v *READLINK3resok) *READLINK3resok { return v }
+type XdrType_READLINK3resfail = *READLINK3resfail
+func (v *READLINK3resfail) XdrPointer() interface{} { return v }
+func (READLINK3resfail) XdrTypeName() string { return "READLINK3resfail" }
+func (v READLINK3resfail) XdrValue() interface{} { return v }
+func (v *READLINK3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READLINK3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%ssymlink_attributes", name), XDR_Post_op_attr(&v.Symlink_attributes))
+}
+func XDR_READLINK3resfail(v *READLINK3resfail) *READLINK3resfail { return v }
+func (_ READLINK3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *READLINK3res) Resok() *READLINK3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*READLINK3resok); ok {
+			return v
+		} else {
+			var zero READLINK3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("READLINK3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *READLINK3res) Resfail() *READLINK3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("READLINK3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*READLINK3resfail); ok {
+			return v
+		} else {
+			var zero READLINK3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u READLINK3res) XdrValid() bool {
+	return true
+}
+func (u *READLINK3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *READLINK3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *READLINK3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_READLINK3resok(u.Resok())
+	default:
+		return XDR_READLINK3resfail(u.Resfail())
+	}
+}
+func (u *READLINK3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_READLINK3res = *READLINK3res
+func (v *READLINK3res) XdrPointer() interface{} { return v }
+func (READLINK3res) XdrTypeName() string { return "READLINK3res" }
+func (v READLINK3res) XdrValue() interface{} { return v }
+func (v *READLINK3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *READLINK3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_READLINK3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_READLINK3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_READLINK3res(v *READLINK3res) *READLINK3res { return v}
+type XdrType_READ3args = *READ3args
+func (v *READ3args) XdrPointer() interface{} { return v }
+func (READ3args) XdrTypeName() string { return "READ3args" }
+func (v READ3args) XdrValue() interface{} { return v }
+func (v *READ3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READ3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile", name), XDR_Nfs_fh3(&v.File))
+	x.Marshal(x.Sprintf("%soffset", name), XDR_Offset3(&v.Offset))
+	x.Marshal(x.Sprintf("%scount", name), XDR_Count3(&v.Count))
+}
+func XDR_READ3args(v *READ3args) *READ3args { return v }
+type XdrType_READ3resok = *READ3resok
+func (v *READ3resok) XdrPointer() interface{} { return v }
+func (READ3resok) XdrTypeName() string { return "READ3resok" }
+func (v READ3resok) XdrValue() interface{} { return v }
+func (v *READ3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READ3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile_attributes", name), XDR_Post_op_attr(&v.File_attributes))
+	x.Marshal(x.Sprintf("%scount", name), XDR_Count3(&v.Count))
+	x.Marshal(x.Sprintf("%seof", name), XDR_bool(&v.Eof))
+	x.Marshal(x.Sprintf("%sdata", name), XdrVecOpaque{&v.Data, 0xffffffff})
+}
+func XDR_READ3resok(v *READ3resok) *READ3resok { return v }
+type XdrType_READ3resfail = *READ3resfail
+func (v *READ3resfail) XdrPointer() interface{} { return v }
+func (READ3resfail) XdrTypeName() string { return "READ3resfail" }
+func (v READ3resfail) XdrValue() interface{} { return v }
+func (v *READ3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READ3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile_attributes", name), XDR_Post_op_attr(&v.File_attributes))
+}
+func XDR_READ3resfail(v *READ3resfail) *READ3resfail { return v }
+func (_ READ3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *READ3res) Resok() *READ3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*READ3resok); ok {
+			return v
+		} else {
+			var zero READ3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("READ3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *READ3res) Resfail() *READ3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("READ3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*READ3resfail); ok {
+			return v
+		} else {
+			var zero READ3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u READ3res) XdrValid() bool {
+	return true
+}
+func (u *READ3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *READ3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *READ3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_READ3resok(u.Resok())
+	default:
+		return XDR_READ3resfail(u.Resfail())
+	}
+}
+func (u *READ3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_READ3res = *READ3res
+func (v *READ3res) XdrPointer() interface{} { return v }
+func (READ3res) XdrTypeName() string { return "READ3res" }
+func (v READ3res) XdrValue() interface{} { return v }
+func (v *READ3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *READ3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_READ3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_READ3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_READ3res(v *READ3res) *READ3res { return v}
+var _XdrNames_Stable_how = map[int32]string{
+	int32(UNSTABLE): "UNSTABLE",
+	int32(DATA_SYNC): "DATA_SYNC",
+	int32(FILE_SYNC): "FILE_SYNC",
+}
+var _XdrValues_Stable_how = map[string]int32{
+	"UNSTABLE": int32(UNSTABLE),
+	"DATA_SYNC": int32(DATA_SYNC),
+	"FILE_SYNC": int32(FILE_SYNC),
+}
+func (Stable_how) XdrEnumNames() map[int32]string {
+	return _XdrNames_Stable_how
+}
+func (v Stable_how) String() string {
+	if s, ok := _XdrNames_Stable_how[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Stable_how#%d", v)
+}
+func (v *Stable_how) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Stable_how[stok]; ok {
+			*v = Stable_how(val)
+			return nil
+		} else if stok == "Stable_how" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Stable_how.", stok))
+	}
+}
+func (v Stable_how) GetU32() uint32 { return uint32(v) }
+func (v *Stable_how) SetU32(n uint32) { *v = Stable_how(n) }
+func (v *Stable_how) XdrPointer() interface{} { return v }
+func (Stable_how) XdrTypeName() string { return "Stable_how" }
+func (v Stable_how) XdrValue() interface{} { return v }
+func (v *Stable_how) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Stable_how = *Stable_how
+func XDR_Stable_how(v *Stable_how) *Stable_how { return v }
+type XdrType_WRITE3args = *WRITE3args
+func (v *WRITE3args) XdrPointer() interface{} { return v }
+func (WRITE3args) XdrTypeName() string { return "WRITE3args" }
+func (v WRITE3args) XdrValue() interface{} { return v }
+func (v *WRITE3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *WRITE3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile", name), XDR_Nfs_fh3(&v.File))
+	x.Marshal(x.Sprintf("%soffset", name), XDR_Offset3(&v.Offset))
+	x.Marshal(x.Sprintf("%scount", name), XDR_Count3(&v.Count))
+	x.Marshal(x.Sprintf("%sstable", name), XDR_Stable_how(&v.Stable))
+	x.Marshal(x.Sprintf("%sdata", name), XdrVecOpaque{&v.Data, 0xffffffff})
+}
+func XDR_WRITE3args(v *WRITE3args) *WRITE3args { return v }
+type XdrType_WRITE3resok = *WRITE3resok
+func (v *WRITE3resok) XdrPointer() interface{} { return v }
+func (WRITE3resok) XdrTypeName() string { return "WRITE3resok" }
+func (v WRITE3resok) XdrValue() interface{} { return v }
+func (v *WRITE3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *WRITE3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile_wcc", name), XDR_Wcc_data(&v.File_wcc))
+	x.Marshal(x.Sprintf("%scount", name), XDR_Count3(&v.Count))
+	x.Marshal(x.Sprintf("%scommitted", name), XDR_Stable_how(&v.Committed))
+	x.Marshal(x.Sprintf("%sverf", name), XDR_Writeverf3(&v.Verf))
+}
+func XDR_WRITE3resok(v *WRITE3resok) *WRITE3resok { return v }
+type XdrType_WRITE3resfail = *WRITE3resfail
+func (v *WRITE3resfail) XdrPointer() interface{} { return v }
+func (WRITE3resfail) XdrTypeName() string { return "WRITE3resfail" }
+func (v WRITE3resfail) XdrValue() interface{} { return v }
+func (v *WRITE3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *WRITE3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile_wcc", name), XDR_Wcc_data(&v.File_wcc))
+}
+func XDR_WRITE3resfail(v *WRITE3resfail) *WRITE3resfail { return v }
+func (_ WRITE3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *WRITE3res) Resok() *WRITE3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*WRITE3resok); ok {
+			return v
+		} else {
+			var zero WRITE3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("WRITE3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *WRITE3res) Resfail() *WRITE3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("WRITE3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*WRITE3resfail); ok {
+			return v
+		} else {
+			var zero WRITE3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u WRITE3res) XdrValid() bool {
+	return true
+}
+func (u *WRITE3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *WRITE3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *WRITE3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_WRITE3resok(u.Resok())
+	default:
+		return XDR_WRITE3resfail(u.Resfail())
+	}
+}
+func (u *WRITE3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_WRITE3res = *WRITE3res
+func (v *WRITE3res) XdrPointer() interface{} { return v }
+func (WRITE3res) XdrTypeName() string { return "WRITE3res" }
+func (v WRITE3res) XdrValue() interface{} { return v }
+func (v *WRITE3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *WRITE3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_WRITE3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_WRITE3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_WRITE3res(v *WRITE3res) *WRITE3res { return v}
+var _XdrNames_Createmode3 = map[int32]string{
+	int32(UNCHECKED): "UNCHECKED",
+	int32(GUARDED): "GUARDED",
+	int32(EXCLUSIVE): "EXCLUSIVE",
+}
+var _XdrValues_Createmode3 = map[string]int32{
+	"UNCHECKED": int32(UNCHECKED),
+	"GUARDED": int32(GUARDED),
+	"EXCLUSIVE": int32(EXCLUSIVE),
+}
+func (Createmode3) XdrEnumNames() map[int32]string {
+	return _XdrNames_Createmode3
+}
+func (v Createmode3) String() string {
+	if s, ok := _XdrNames_Createmode3[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Createmode3#%d", v)
+}
+func (v *Createmode3) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Createmode3[stok]; ok {
+			*v = Createmode3(val)
+			return nil
+		} else if stok == "Createmode3" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Createmode3.", stok))
+	}
+}
+func (v Createmode3) GetU32() uint32 { return uint32(v) }
+func (v *Createmode3) SetU32(n uint32) { *v = Createmode3(n) }
+func (v *Createmode3) XdrPointer() interface{} { return v }
+func (Createmode3) XdrTypeName() string { return "Createmode3" }
+func (v Createmode3) XdrValue() interface{} { return v }
+func (v *Createmode3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Createmode3 = *Createmode3
+func XDR_Createmode3(v *Createmode3) *Createmode3 { return v }
+var _XdrTags_Createhow3 = map[int32]bool{
+	XdrToI32(UNCHECKED): true,
+	XdrToI32(GUARDED): true,
+	XdrToI32(EXCLUSIVE): true,
+}
+func (_ Createhow3) XdrValidTags() map[int32]bool {
+	return _XdrTags_Createhow3
+}
+func (u *Createhow3) Obj_attributes() *Sattr3 {
+	switch u.Mode {
+	case UNCHECKED, GUARDED:
+		if v, ok := u._u.(*Sattr3); ok {
+			return v
+		} else {
+			var zero Sattr3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Createhow3.Obj_attributes accessed when Mode == %v", u.Mode)
+		return nil
+	}
+}
+func (u *Createhow3) Verf() *Createverf3 {
+	switch u.Mode {
+	case EXCLUSIVE:
+		if v, ok := u._u.(*Createverf3); ok {
+			return v
+		} else {
+			var zero Createverf3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Createhow3.Verf accessed when Mode == %v", u.Mode)
+		return nil
+	}
+}
+func (u Createhow3) XdrValid() bool {
+	switch u.Mode {
+	case UNCHECKED, GUARDED,EXCLUSIVE:
+		return true
+	}
+	return false
+}
+func (u *Createhow3) XdrUnionTag() XdrNum32 {
+	return XDR_Createmode3(&u.Mode)
+}
+func (u *Createhow3) XdrUnionTagName() string {
+	return "Mode"
+}
+func (u *Createhow3) XdrUnionBody() XdrType {
+	switch u.Mode {
+	case UNCHECKED, GUARDED:
+		return XDR_Sattr3(u.Obj_attributes())
+	case EXCLUSIVE:
+		return XDR_Createverf3(u.Verf())
+	}
+	return nil
+}
+func (u *Createhow3) XdrUnionBodyName() string {
+	switch u.Mode {
+	case UNCHECKED, GUARDED:
+		return "Obj_attributes"
+	case EXCLUSIVE:
+		return "Verf"
+	}
+	return ""
+}
+type XdrType_Createhow3 = *Createhow3
+func (v *Createhow3) XdrPointer() interface{} { return v }
+func (Createhow3) XdrTypeName() string { return "Createhow3" }
+func (v Createhow3) XdrValue() interface{} { return v }
+func (v *Createhow3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Createhow3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Createmode3(&u.Mode).XdrMarshal(x, x.Sprintf("%smode", name))
+	switch u.Mode {
+	case UNCHECKED, GUARDED:
+		x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Sattr3(u.Obj_attributes()))
+		return
+	case EXCLUSIVE:
+		x.Marshal(x.Sprintf("%sverf", name), XDR_Createverf3(u.Verf()))
+		return
+	}
+	XdrPanic("invalid Mode (%v) in Createhow3", u.Mode)
+}
+func XDR_Createhow3(v *Createhow3) *Createhow3 { return v}
+type XdrType_CREATE3args = *CREATE3args
+func (v *CREATE3args) XdrPointer() interface{} { return v }
+func (CREATE3args) XdrTypeName() string { return "CREATE3args" }
+func (v CREATE3args) XdrValue() interface{} { return v }
+func (v *CREATE3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *CREATE3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%swhere", name), XDR_Diropargs3(&v.Where))
+	x.Marshal(x.Sprintf("%show", name), XDR_Createhow3(&v.How))
+}
+func XDR_CREATE3args(v *CREATE3args) *CREATE3args { return v }
+type XdrType_CREATE3resok = *CREATE3resok
+func (v *CREATE3resok) XdrPointer() interface{} { return v }
+func (CREATE3resok) XdrTypeName() string { return "CREATE3resok" }
+func (v CREATE3resok) XdrValue() interface{} { return v }
+func (v *CREATE3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *CREATE3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj", name), XDR_Post_op_fh3(&v.Obj))
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_CREATE3resok(v *CREATE3resok) *CREATE3resok { return v }
+type XdrType_CREATE3resfail = *CREATE3resfail
+func (v *CREATE3resfail) XdrPointer() interface{} { return v }
+func (CREATE3resfail) XdrTypeName() string { return "CREATE3resfail" }
+func (v CREATE3resfail) XdrValue() interface{} { return v }
+func (v *CREATE3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *CREATE3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_CREATE3resfail(v *CREATE3resfail) *CREATE3resfail { return v }
+func (_ CREATE3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *CREATE3res) Resok() *CREATE3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*CREATE3resok); ok {
+			return v
+		} else {
+			var zero CREATE3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("CREATE3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *CREATE3res) Resfail() *CREATE3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("CREATE3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*CREATE3resfail); ok {
+			return v
+		} else {
+			var zero CREATE3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u CREATE3res) XdrValid() bool {
+	return true
+}
+func (u *CREATE3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *CREATE3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *CREATE3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_CREATE3resok(u.Resok())
+	default:
+		return XDR_CREATE3resfail(u.Resfail())
+	}
+}
+func (u *CREATE3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_CREATE3res = *CREATE3res
+func (v *CREATE3res) XdrPointer() interface{} { return v }
+func (CREATE3res) XdrTypeName() string { return "CREATE3res" }
+func (v CREATE3res) XdrValue() interface{} { return v }
+func (v *CREATE3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *CREATE3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_CREATE3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_CREATE3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_CREATE3res(v *CREATE3res) *CREATE3res { return v}
+type XdrType_MKDIR3args = *MKDIR3args
+func (v *MKDIR3args) XdrPointer() interface{} { return v }
+func (MKDIR3args) XdrTypeName() string { return "MKDIR3args" }
+func (v MKDIR3args) XdrValue() interface{} { return v }
+func (v *MKDIR3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *MKDIR3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%swhere", name), XDR_Diropargs3(&v.Where))
+	x.Marshal(x.Sprintf("%sattributes", name), XDR_Sattr3(&v.Attributes))
+}
+func XDR_MKDIR3args(v *MKDIR3args) *MKDIR3args { return v }
+type XdrType_MKDIR3resok = *MKDIR3resok
+func (v *MKDIR3resok) XdrPointer() interface{} { return v }
+func (MKDIR3resok) XdrTypeName() string { return "MKDIR3resok" }
+func (v MKDIR3resok) XdrValue() interface{} { return v }
+func (v *MKDIR3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *MKDIR3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj", name), XDR_Post_op_fh3(&v.Obj))
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_MKDIR3resok(v *MKDIR3resok) *MKDIR3resok { return v }
+type XdrType_MKDIR3resfail = *MKDIR3resfail
+func (v *MKDIR3resfail) XdrPointer() interface{} { return v }
+func (MKDIR3resfail) XdrTypeName() string { return "MKDIR3resfail" }
+func (v MKDIR3resfail) XdrValue() interface{} { return v }
+func (v *MKDIR3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *MKDIR3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_MKDIR3resfail(v *MKDIR3resfail) *MKDIR3resfail { return v }
+func (_ MKDIR3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *MKDIR3res) Resok() *MKDIR3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*MKDIR3resok); ok {
+			return v
+		} else {
+			var zero MKDIR3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("MKDIR3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *MKDIR3res) Resfail() *MKDIR3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("MKDIR3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*MKDIR3resfail); ok {
+			return v
+		} else {
+			var zero MKDIR3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u MKDIR3res) XdrValid() bool {
+	return true
+}
+func (u *MKDIR3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *MKDIR3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *MKDIR3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_MKDIR3resok(u.Resok())
+	default:
+		return XDR_MKDIR3resfail(u.Resfail())
+	}
+}
+func (u *MKDIR3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_MKDIR3res = *MKDIR3res
+func (v *MKDIR3res) XdrPointer() interface{} { return v }
+func (MKDIR3res) XdrTypeName() string { return "MKDIR3res" }
+func (v MKDIR3res) XdrValue() interface{} { return v }
+func (v *MKDIR3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *MKDIR3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_MKDIR3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_MKDIR3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_MKDIR3res(v *MKDIR3res) *MKDIR3res { return v}
+type XdrType_Symlinkdata3 = *Symlinkdata3
+func (v *Symlinkdata3) XdrPointer() interface{} { return v }
+func (Symlinkdata3) XdrTypeName() string { return "Symlinkdata3" }
+func (v Symlinkdata3) XdrValue() interface{} { return v }
+func (v *Symlinkdata3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Symlinkdata3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%ssymlink_attributes", name), XDR_Sattr3(&v.Symlink_attributes))
+	x.Marshal(x.Sprintf("%ssymlink_data", name), XDR_Nfspath3(&v.Symlink_data))
+}
+func XDR_Symlinkdata3(v *Symlinkdata3) *Symlinkdata3 { return v }
+type XdrType_SYMLINK3args = *SYMLINK3args
+func (v *SYMLINK3args) XdrPointer() interface{} { return v }
+func (SYMLINK3args) XdrTypeName() string { return "SYMLINK3args" }
+func (v SYMLINK3args) XdrValue() interface{} { return v }
+func (v *SYMLINK3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *SYMLINK3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%swhere", name), XDR_Diropargs3(&v.Where))
+	x.Marshal(x.Sprintf("%ssymlink", name), XDR_Symlinkdata3(&v.Symlink))
+}
+func XDR_SYMLINK3args(v *SYMLINK3args) *SYMLINK3args { return v }
+type XdrType_SYMLINK3resok = *SYMLINK3resok
+func (v *SYMLINK3resok) XdrPointer() interface{} { return v }
+func (SYMLINK3resok) XdrTypeName() string { return "SYMLINK3resok" }
+func (v SYMLINK3resok) XdrValue() interface{} { return v }
+func (v *SYMLINK3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *SYMLINK3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj", name), XDR_Post_op_fh3(&v.Obj))
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_SYMLINK3resok(v *SYMLINK3resok) *SYMLINK3resok { return v }
+type XdrType_SYMLINK3resfail = *SYMLINK3resfail
+func (v *SYMLINK3resfail) XdrPointer() interface{} { return v }
+func (SYMLINK3resfail) XdrTypeName() string { return "SYMLINK3resfail" }
+func (v SYMLINK3resfail) XdrValue() interface{} { return v }
+func (v *SYMLINK3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *SYMLINK3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_SYMLINK3resfail(v *SYMLINK3resfail) *SYMLINK3resfail { return v }
+func (_ SYMLINK3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *SYMLINK3res) Resok() *SYMLINK3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*SYMLINK3resok); ok {
+			return v
+		} else {
+			var zero SYMLINK3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("SYMLINK3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *SYMLINK3res) Resfail() *SYMLINK3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("SYMLINK3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*SYMLINK3resfail); ok {
+			return v
+		} else {
+			var zero SYMLINK3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u SYMLINK3res) XdrValid() bool {
+	return true
+}
+func (u *SYMLINK3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *SYMLINK3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *SYMLINK3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_SYMLINK3resok(u.Resok())
+	default:
+		return XDR_SYMLINK3resfail(u.Resfail())
+	}
+}
+func (u *SYMLINK3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_SYMLINK3res = *SYMLINK3res
+func (v *SYMLINK3res) XdrPointer() interface{} { return v }
+func (SYMLINK3res) XdrTypeName() string { return "SYMLINK3res" }
+func (v SYMLINK3res) XdrValue() interface{} { return v }
+func (v *SYMLINK3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *SYMLINK3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_SYMLINK3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_SYMLINK3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_SYMLINK3res(v *SYMLINK3res) *SYMLINK3res { return v}
+type XdrType_Devicedata3 = *Devicedata3
+func (v *Devicedata3) XdrPointer() interface{} { return v }
+func (Devicedata3) XdrTypeName() string { return "Devicedata3" }
+func (v Devicedata3) XdrValue() interface{} { return v }
+func (v *Devicedata3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Devicedata3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdev_attributes", name), XDR_Sattr3(&v.Dev_attributes))
+	x.Marshal(x.Sprintf("%sspec", name), XDR_Specdata3(&v.Spec))
+}
+func XDR_Devicedata3(v *Devicedata3) *Devicedata3 { return v }
+func (_ Mknoddata3) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Mknoddata3) Device() *Devicedata3 {
+	switch u.Type {
+	case NF3CHR, NF3BLK:
+		if v, ok := u._u.(*Devicedata3); ok {
+			return v
+		} else {
+			var zero Devicedata3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Mknoddata3.Device accessed when Type == %v", u.Type)
+		return nil
+	}
+}
+func (u *Mknoddata3) Pipe_attributes() *Sattr3 {
+	switch u.Type {
+	case NF3SOCK, NF3FIFO:
+		if v, ok := u._u.(*Sattr3); ok {
+			return v
+		} else {
+			var zero Sattr3
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Mknoddata3.Pipe_attributes accessed when Type == %v", u.Type)
+		return nil
+	}
+}
+func (u Mknoddata3) XdrValid() bool {
+	return true
+}
+func (u *Mknoddata3) XdrUnionTag() XdrNum32 {
+	return XDR_Ftype3(&u.Type)
+}
+func (u *Mknoddata3) XdrUnionTagName() string {
+	return "Type"
+}
+func (u *Mknoddata3) XdrUnionBody() XdrType {
+	switch u.Type {
+	case NF3CHR, NF3BLK:
+		return XDR_Devicedata3(u.Device())
+	case NF3SOCK, NF3FIFO:
+		return XDR_Sattr3(u.Pipe_attributes())
+	default:
+		return nil
+	}
+}
+func (u *Mknoddata3) XdrUnionBodyName() string {
+	switch u.Type {
+	case NF3CHR, NF3BLK:
+		return "Device"
+	case NF3SOCK, NF3FIFO:
+		return "Pipe_attributes"
+	default:
+		return ""
+	}
+}
+type XdrType_Mknoddata3 = *Mknoddata3
+func (v *Mknoddata3) XdrPointer() interface{} { return v }
+func (Mknoddata3) XdrTypeName() string { return "Mknoddata3" }
+func (v Mknoddata3) XdrValue() interface{} { return v }
+func (v *Mknoddata3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Mknoddata3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Ftype3(&u.Type).XdrMarshal(x, x.Sprintf("%stype", name))
+	switch u.Type {
+	case NF3CHR, NF3BLK:
+		x.Marshal(x.Sprintf("%sdevice", name), XDR_Devicedata3(u.Device()))
+		return
+	case NF3SOCK, NF3FIFO:
+		x.Marshal(x.Sprintf("%spipe_attributes", name), XDR_Sattr3(u.Pipe_attributes()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Mknoddata3(v *Mknoddata3) *Mknoddata3 { return v}
+type XdrType_MKNOD3args = *MKNOD3args
+func (v *MKNOD3args) XdrPointer() interface{} { return v }
+func (MKNOD3args) XdrTypeName() string { return "MKNOD3args" }
+func (v MKNOD3args) XdrValue() interface{} { return v }
+func (v *MKNOD3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *MKNOD3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%swhere", name), XDR_Diropargs3(&v.Where))
+	x.Marshal(x.Sprintf("%swhat", name), XDR_Mknoddata3(&v.What))
+}
+func XDR_MKNOD3args(v *MKNOD3args) *MKNOD3args { return v }
+type XdrType_MKNOD3resok = *MKNOD3resok
+func (v *MKNOD3resok) XdrPointer() interface{} { return v }
+func (MKNOD3resok) XdrTypeName() string { return "MKNOD3resok" }
+func (v MKNOD3resok) XdrValue() interface{} { return v }
+func (v *MKNOD3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *MKNOD3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj", name), XDR_Post_op_fh3(&v.Obj))
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_MKNOD3resok(v *MKNOD3resok) *MKNOD3resok { return v }
+type XdrType_MKNOD3resfail = *MKNOD3resfail
+func (v *MKNOD3resfail) XdrPointer() interface{} { return v }
+func (MKNOD3resfail) XdrTypeName() string { return "MKNOD3resfail" }
+func (v MKNOD3resfail) XdrValue() interface{} { return v }
+func (v *MKNOD3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *MKNOD3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_MKNOD3resfail(v *MKNOD3resfail) *MKNOD3resfail { return v }
+func (_ MKNOD3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *MKNOD3res) Resok() *MKNOD3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*MKNOD3resok); ok {
+			return v
+		} else {
+			var zero MKNOD3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("MKNOD3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *MKNOD3res) Resfail() *MKNOD3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("MKNOD3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*MKNOD3resfail); ok {
+			return v
+		} else {
+			var zero MKNOD3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u MKNOD3res) XdrValid() bool {
+	return true
+}
+func (u *MKNOD3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *MKNOD3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *MKNOD3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_MKNOD3resok(u.Resok())
+	default:
+		return XDR_MKNOD3resfail(u.Resfail())
+	}
+}
+func (u *MKNOD3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_MKNOD3res = *MKNOD3res
+func (v *MKNOD3res) XdrPointer() interface{} { return v }
+func (MKNOD3res) XdrTypeName() string { return "MKNOD3res" }
+func (v MKNOD3res) XdrValue() interface{} { return v }
+func (v *MKNOD3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *MKNOD3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_MKNOD3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_MKNOD3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_MKNOD3res(v *MKNOD3res) *MKNOD3res { return v}
+type XdrType_REMOVE3args = *REMOVE3args
+func (v *REMOVE3args) XdrPointer() interface{} { return v }
+func (REMOVE3args) XdrTypeName() string { return "REMOVE3args" }
+func (v REMOVE3args) XdrValue() interface{} { return v }
+func (v *REMOVE3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *REMOVE3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobject", name), XDR_Diropargs3(&v.Object))
+}
+func XDR_REMOVE3args(v *REMOVE3args) *REMOVE3args { return v }
+type XdrType_REMOVE3resok = *REMOVE3resok
+func (v *REMOVE3resok) XdrPointer() interface{} { return v }
+func (REMOVE3resok) XdrTypeName() string { return "REMOVE3resok" }
+func (v REMOVE3resok) XdrValue() interface{} { return v }
+func (v *REMOVE3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *REMOVE3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_REMOVE3resok(v *REMOVE3resok) *REMOVE3resok { return v }
+type XdrType_REMOVE3resfail = *REMOVE3resfail
+func (v *REMOVE3resfail) XdrPointer() interface{} { return v }
+func (REMOVE3resfail) XdrTypeName() string { return "REMOVE3resfail" }
+func (v REMOVE3resfail) XdrValue() interface{} { return v }
+func (v *REMOVE3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *REMOVE3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_REMOVE3resfail(v *REMOVE3resfail) *REMOVE3resfail { return v }
+func (_ REMOVE3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *REMOVE3res) Resok() *REMOVE3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*REMOVE3resok); ok {
+			return v
+		} else {
+			var zero REMOVE3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("REMOVE3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *REMOVE3res) Resfail() *REMOVE3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("REMOVE3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*REMOVE3resfail); ok {
+			return v
+		} else {
+			var zero REMOVE3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u REMOVE3res) XdrValid() bool {
+	return true
+}
+func (u *REMOVE3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *REMOVE3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *REMOVE3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_REMOVE3resok(u.Resok())
+	default:
+		return XDR_REMOVE3resfail(u.Resfail())
+	}
+}
+func (u *REMOVE3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_REMOVE3res = *REMOVE3res
+func (v *REMOVE3res) XdrPointer() interface{} { return v }
+func (REMOVE3res) XdrTypeName() string { return "REMOVE3res" }
+func (v REMOVE3res) XdrValue() interface{} { return v }
+func (v *REMOVE3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *REMOVE3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_REMOVE3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_REMOVE3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_REMOVE3res(v *REMOVE3res) *REMOVE3res { return v}
+type XdrType_RMDIR3args = *RMDIR3args
+func (v *RMDIR3args) XdrPointer() interface{} { return v }
+func (RMDIR3args) XdrTypeName() string { return "RMDIR3args" }
+func (v RMDIR3args) XdrValue() interface{} { return v }
+func (v *RMDIR3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *RMDIR3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobject", name), XDR_Diropargs3(&v.Object))
+}
+func XDR_RMDIR3args(v *RMDIR3args) *RMDIR3args { return v }
+type XdrType_RMDIR3resok = *RMDIR3resok
+func (v *RMDIR3resok) XdrPointer() interface{} { return v }
+func (RMDIR3resok) XdrTypeName() string { return "RMDIR3resok" }
+func (v RMDIR3resok) XdrValue() interface{} { return v }
+func (v *RMDIR3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *RMDIR3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_RMDIR3resok(v *RMDIR3resok) *RMDIR3resok { return v }
+type XdrType_RMDIR3resfail = *RMDIR3resfail
+func (v *RMDIR3resfail) XdrPointer() interface{} { return v }
+func (RMDIR3resfail) XdrTypeName() string { return "RMDIR3resfail" }
+func (v RMDIR3resfail) XdrValue() interface{} { return v }
+func (v *RMDIR3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *RMDIR3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_wcc", name), XDR_Wcc_data(&v.Dir_wcc))
+}
+func XDR_RMDIR3resfail(v *RMDIR3resfail) *RMDIR3resfail { return v }
+func (_ RMDIR3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *RMDIR3res) Resok() *RMDIR3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*RMDIR3resok); ok {
+			return v
+		} else {
+			var zero RMDIR3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("RMDIR3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *RMDIR3res) Resfail() *RMDIR3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("RMDIR3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*RMDIR3resfail); ok {
+			return v
+		} else {
+			var zero RMDIR3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u RMDIR3res) XdrValid() bool {
+	return true
+}
+func (u *RMDIR3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *RMDIR3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *RMDIR3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_RMDIR3resok(u.Resok())
+	default:
+		return XDR_RMDIR3resfail(u.Resfail())
+	}
+}
+func (u *RMDIR3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_RMDIR3res = *RMDIR3res
+func (v *RMDIR3res) XdrPointer() interface{} { return v }
+func (RMDIR3res) XdrTypeName() string { return "RMDIR3res" }
+func (v RMDIR3res) XdrValue() interface{} { return v }
+func (v *RMDIR3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *RMDIR3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_RMDIR3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_RMDIR3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_RMDIR3res(v *RMDIR3res) *RMDIR3res { return v}
+type XdrType_RENAME3args = *RENAME3args
+func (v *RENAME3args) XdrPointer() interface{} { return v }
+func (RENAME3args) XdrTypeName() string { return "RENAME3args" }
+func (v RENAME3args) XdrValue() interface{} { return v }
+func (v *RENAME3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *RENAME3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfrom", name), XDR_Diropargs3(&v.From))
+	x.Marshal(x.Sprintf("%sto", name), XDR_Diropargs3(&v.To))
+}
+func XDR_RENAME3args(v *RENAME3args) *RENAME3args { return v }
+type XdrType_RENAME3resok = *RENAME3resok
+func (v *RENAME3resok) XdrPointer() interface{} { return v }
+func (RENAME3resok) XdrTypeName() string { return "RENAME3resok" }
+func (v RENAME3resok) XdrValue() interface{} { return v }
+func (v *RENAME3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *RENAME3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfromdir_wcc", name), XDR_Wcc_data(&v.Fromdir_wcc))
+	x.Marshal(x.Sprintf("%stodir_wcc", name), XDR_Wcc_data(&v.Todir_wcc))
+}
+func XDR_RENAME3resok(v *RENAME3resok) *RENAME3resok { return v }
+type XdrType_RENAME3resfail = *RENAME3resfail
+func (v *RENAME3resfail) XdrPointer() interface{} { return v }
+func (RENAME3resfail) XdrTypeName() string { return "RENAME3resfail" }
+func (v RENAME3resfail) XdrValue() interface{} { return v }
+func (v *RENAME3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *RENAME3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfromdir_wcc", name), XDR_Wcc_data(&v.Fromdir_wcc))
+	x.Marshal(x.Sprintf("%stodir_wcc", name), XDR_Wcc_data(&v.Todir_wcc))
+}
+func XDR_RENAME3resfail(v *RENAME3resfail) *RENAME3resfail { return v }
+func (_ RENAME3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *RENAME3res) Resok() *RENAME3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*RENAME3resok); ok {
+			return v
+		} else {
+			var zero RENAME3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("RENAME3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *RENAME3res) Resfail() *RENAME3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("RENAME3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*RENAME3resfail); ok {
+			return v
+		} else {
+			var zero RENAME3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u RENAME3res) XdrValid() bool {
+	return true
+}
+func (u *RENAME3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *RENAME3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *RENAME3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_RENAME3resok(u.Resok())
+	default:
+		return XDR_RENAME3resfail(u.Resfail())
+	}
+}
+func (u *RENAME3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_RENAME3res = *RENAME3res
+func (v *RENAME3res) XdrPointer() interface{} { return v }
+func (RENAME3res) XdrTypeName() string { return "RENAME3res" }
+func (v RENAME3res) XdrValue() interface{} { return v }
+func (v *RENAME3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *RENAME3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_RENAME3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_RENAME3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_RENAME3res(v *RENAME3res) *RENAME3res { return v}
+type XdrType_LINK3args = *LINK3args
+func (v *LINK3args) XdrPointer() interface{} { return v }
+func (LINK3args) XdrTypeName() string { return "LINK3args" }
+func (v LINK3args) XdrValue() interface{} { return v }
+func (v *LINK3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *LINK3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile", name), XDR_Nfs_fh3(&v.File))
+	x.Marshal(x.Sprintf("%slink", name), XDR_Diropargs3(&v.Link))
+}
+func XDR_LINK3args(v *LINK3args) *LINK3args { return v }
+type XdrType_LINK3resok = *LINK3resok
+func (v *LINK3resok) XdrPointer() interface{} { return v }
+func (LINK3resok) XdrTypeName() string { return "LINK3resok" }
+func (v LINK3resok) XdrValue() interface{} { return v }
+func (v *LINK3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *LINK3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile_attributes", name), XDR_Post_op_attr(&v.File_attributes))
+	x.Marshal(x.Sprintf("%slinkdir_wcc", name), XDR_Wcc_data(&v.Linkdir_wcc))
+}
+func XDR_LINK3resok(v *LINK3resok) *LINK3resok { return v }
+type XdrType_LINK3resfail = *LINK3resfail
+func (v *LINK3resfail) XdrPointer() interface{} { return v }
+func (LINK3resfail) XdrTypeName() string { return "LINK3resfail" }
+func (v LINK3resfail) XdrValue() interface{} { return v }
+func (v *LINK3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *LINK3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile_attributes", name), XDR_Post_op_attr(&v.File_attributes))
+	x.Marshal(x.Sprintf("%slinkdir_wcc", name), XDR_Wcc_data(&v.Linkdir_wcc))
+}
+func XDR_LINK3resfail(v *LINK3resfail) *LINK3resfail { return v }
+func (_ LINK3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *LINK3res) Resok() *LINK3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*LINK3resok); ok {
+			return v
+		} else {
+			var zero LINK3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("LINK3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *LINK3res) Resfail() *LINK3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("LINK3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*LINK3resfail); ok {
+			return v
+		} else {
+			var zero LINK3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u LINK3res) XdrValid() bool {
+	return true
+}
+func (u *LINK3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *LINK3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *LINK3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_LINK3resok(u.Resok())
+	default:
+		return XDR_LINK3resfail(u.Resfail())
+	}
+}
+func (u *LINK3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_LINK3res = *LINK3res
+func (v *LINK3res) XdrPointer() interface{} { return v }
+func (LINK3res) XdrTypeName() string { return "LINK3res" }
+func (v LINK3res) XdrValue() interface{} { return v }
+func (v *LINK3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *LINK3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_LINK3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_LINK3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_LINK3res(v *LINK3res) *LINK3res { return v}
+type XdrType_READDIR3args = *READDIR3args
+func (v *READDIR3args) XdrPointer() interface{} { return v }
+func (READDIR3args) XdrTypeName() string { return "READDIR3args" }
+func (v READDIR3args) XdrValue() interface{} { return v }
+func (v *READDIR3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READDIR3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir", name), XDR_Nfs_fh3(&v.Dir))
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Cookie3(&v.Cookie))
+	x.Marshal(x.Sprintf("%scookieverf", name), XDR_Cookieverf3(&v.Cookieverf))
+	x.Marshal(x.Sprintf("%scount", name), XDR_Count3(&v.Count))
+}
+func XDR_READDIR3args(v *READDIR3args) *READDIR3args { return v }
+type _XdrPtr_Entry3 struct {
+	p **Entry3
+}
+type _ptrflag_Entry3 _XdrPtr_Entry3
+func (v _ptrflag_Entry3) String() string {
+	if *v.p == nil {
+		return "nil"
+	}
+	return "non-nil"
+}
+func (v _ptrflag_Entry3) Scan(ss fmt.ScanState, r rune) error {
+	tok, err := ss.Token(true, func(c rune) bool {
+		return c == '-' || (c >= 'a' && c <= 'z')
+	})
+	if err != nil {
+		return err
+	}
+	switch string(tok) {
+	case "nil":
+		v.SetU32(0)
+	case "non-nil":
+		v.SetU32(1)
+	default:
+		return XdrError("Entry3 flag should be \"nil\" or \"non-nil\"")
+	}
+	return nil
+}
+func (v _ptrflag_Entry3) GetU32() uint32 {
+	if *v.p == nil {
+		return 0
+	}
+	return 1
+}
+func (v _ptrflag_Entry3) SetU32(nv uint32) {
+	switch nv {
+	case 0:
+		*v.p = nil
+	case 1:
+		if *v.p == nil {
+			*v.p = new(Entry3)
+		}
+	default:
+		XdrPanic("*Entry3 present flag value %d should be 0 or 1", nv)
+	}
+}
+func (_ptrflag_Entry3) XdrTypeName() string { return "Entry3?" }
+func (v _ptrflag_Entry3) XdrPointer() interface{} { return nil }
+func (v _ptrflag_Entry3) XdrValue() interface{} { return v.GetU32() != 0 }
+func (v _ptrflag_Entry3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _ptrflag_Entry3) XdrBound() uint32 { return 1 }
+func (v _XdrPtr_Entry3) GetPresent() bool { return *v.p != nil }
+func (v _XdrPtr_Entry3) SetPresent(present bool) {
+	if !present {
+		*v.p = nil
+	} else if *v.p == nil {
+		*v.p = new(Entry3)
+	}
+}
+func (v _XdrPtr_Entry3) XdrMarshalValue(x XDR, name string) {
+	if *v.p != nil {
+		XDR_Entry3(*v.p).XdrMarshal(x, name)
+	}
+}
+func (v _XdrPtr_Entry3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _XdrPtr_Entry3) XdrRecurse(x XDR, name string) {
+	x.Marshal(name, _ptrflag_Entry3(v))
+	v.XdrMarshalValue(x, name)
+}
+func (_XdrPtr_Entry3) XdrTypeName() string { return "Entry3*" }
+func (v _XdrPtr_Entry3) XdrPointer() interface{} { return v.p }
+func (v _XdrPtr_Entry3) XdrValue() interface{} { return *v.p }
+type XdrType_Entry3 = *Entry3
+func (v *Entry3) XdrPointer() interface{} { return v }
+func (Entry3) XdrTypeName() string { return "Entry3" }
+func (v Entry3) XdrValue() interface{} { return v }
+func (v *Entry3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Entry3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfileid", name), XDR_Fileid3(&v.Fileid))
+	x.Marshal(x.Sprintf("%sname", name), XDR_Filename3(&v.Name))
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Cookie3(&v.Cookie))
+	x.Marshal(x.Sprintf("%snextentry", name), _XdrPtr_Entry3{&v.Nextentry})
+}
+func XDR_Entry3(v *Entry3) *Entry3 { return v }
+type XdrType_Dirlist3 = *Dirlist3
+func (v *Dirlist3) XdrPointer() interface{} { return v }
+func (Dirlist3) XdrTypeName() string { return "Dirlist3" }
+func (v Dirlist3) XdrValue() interface{} { return v }
+func (v *Dirlist3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Dirlist3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sentries", name), _XdrPtr_Entry3{&v.Entries})
+	x.Marshal(x.Sprintf("%seof", name), XDR_bool(&v.Eof))
+}
+func XDR_Dirlist3(v *Dirlist3) *Dirlist3 { return v }
+type XdrType_READDIR3resok = *READDIR3resok
+func (v *READDIR3resok) XdrPointer() interface{} { return v }
+func (READDIR3resok) XdrTypeName() string { return "READDIR3resok" }
+func (v READDIR3resok) XdrValue() interface{} { return v }
+func (v *READDIR3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READDIR3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_attributes", name), XDR_Post_op_attr(&v.Dir_attributes))
+	x.Marshal(x.Sprintf("%scookieverf", name), XDR_Cookieverf3(&v.Cookieverf))
+	x.Marshal(x.Sprintf("%sreply", name), XDR_Dirlist3(&v.Reply))
+}
+func XDR_READDIR3resok(v *READDIR3resok) *READDIR3resok { return v }
+type XdrType_READDIR3resfail = *READDIR3resfail
+func (v *READDIR3resfail) XdrPointer() interface{} { return v }
+func (READDIR3resfail) XdrTypeName() string { return "READDIR3resfail" }
+func (v READDIR3resfail) XdrValue() interface{} { return v }
+func (v *READDIR3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READDIR3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_attributes", name), XDR_Post_op_attr(&v.Dir_attributes))
+}
+func XDR_READDIR3resfail(v *READDIR3resfail) *READDIR3resfail { return v }
+func (_ READDIR3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *READDIR3res) Resok() *READDIR3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*READDIR3resok); ok {
+			return v
+		} else {
+			var zero READDIR3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("READDIR3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *READDIR3res) Resfail() *READDIR3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("READDIR3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*READDIR3resfail); ok {
+			return v
+		} else {
+			var zero READDIR3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u READDIR3res) XdrValid() bool {
+	return true
+}
+func (u *READDIR3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *READDIR3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *READDIR3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_READDIR3resok(u.Resok())
+	default:
+		return XDR_READDIR3resfail(u.Resfail())
+	}
+}
+func (u *READDIR3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_READDIR3res = *READDIR3res
+func (v *READDIR3res) XdrPointer() interface{} { return v }
+func (READDIR3res) XdrTypeName() string { return "READDIR3res" }
+func (v READDIR3res) XdrValue() interface{} { return v }
+func (v *READDIR3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *READDIR3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_READDIR3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_READDIR3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_READDIR3res(v *READDIR3res) *READDIR3res { return v}
+type XdrType_READDIRPLUS3args = *READDIRPLUS3args
+func (v *READDIRPLUS3args) XdrPointer() interface{} { return v }
+func (READDIRPLUS3args) XdrTypeName() string { return "READDIRPLUS3args" }
+func (v READDIRPLUS3args) XdrValue() interface{} { return v }
+func (v *READDIRPLUS3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READDIRPLUS3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir", name), XDR_Nfs_fh3(&v.Dir))
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Cookie3(&v.Cookie))
+	x.Marshal(x.Sprintf("%scookieverf", name), XDR_Cookieverf3(&v.Cookieverf))
+	x.Marshal(x.Sprintf("%sdircount", name), XDR_Count3(&v.Dircount))
+	x.Marshal(x.Sprintf("%smaxcount", name), XDR_Count3(&v.Maxcount))
+}
+func XDR_READDIRPLUS3args(v *READDIRPLUS3args) *READDIRPLUS3args { return v }
+type _XdrPtr_Entryplus3 struct {
+	p **Entryplus3
+}
+type _ptrflag_Entryplus3 _XdrPtr_Entryplus3
+func (v _ptrflag_Entryplus3) String() string {
+	if *v.p == nil {
+		return "nil"
+	}
+	return "non-nil"
+}
+func (v _ptrflag_Entryplus3) Scan(ss fmt.ScanState, r rune) error {
+	tok, err := ss.Token(true, func(c rune) bool {
+		return c == '-' || (c >= 'a' && c <= 'z')
+	})
+	if err != nil {
+		return err
+	}
+	switch string(tok) {
+	case "nil":
+		v.SetU32(0)
+	case "non-nil":
+		v.SetU32(1)
+	default:
+		return XdrError("Entryplus3 flag should be \"nil\" or \"non-nil\"")
+	}
+	return nil
+}
+func (v _ptrflag_Entryplus3) GetU32() uint32 {
+	if *v.p == nil {
+		return 0
+	}
+	return 1
+}
+func (v _ptrflag_Entryplus3) SetU32(nv uint32) {
+	switch nv {
+	case 0:
+		*v.p = nil
+	case 1:
+		if *v.p == nil {
+			*v.p = new(Entryplus3)
+		}
+	default:
+		XdrPanic("*Entryplus3 present flag value %d should be 0 or 1", nv)
+	}
+}
+func (_ptrflag_Entryplus3) XdrTypeName() string { return "Entryplus3?" }
+func (v _ptrflag_Entryplus3) XdrPointer() interface{} { return nil }
+func (v _ptrflag_Entryplus3) XdrValue() interface{} { return v.GetU32() != 0 }
+func (v _ptrflag_Entryplus3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _ptrflag_Entryplus3) XdrBound() uint32 { return 1 }
+func (v _XdrPtr_Entryplus3) GetPresent() bool { return *v.p != nil }
+func (v _XdrPtr_Entryplus3) SetPresent(present bool) {
+	if !present {
+		*v.p = nil
+	} else if *v.p == nil {
+		*v.p = new(Entryplus3)
+	}
+}
+func (v _XdrPtr_Entryplus3) XdrMarshalValue(x XDR, name string) {
+	if *v.p != nil {
+		XDR_Entryplus3(*v.p).XdrMarshal(x, name)
+	}
+}
+func (v _XdrPtr_Entryplus3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _XdrPtr_Entryplus3) XdrRecurse(x XDR, name string) {
+	x.Marshal(name, _ptrflag_Entryplus3(v))
+	v.XdrMarshalValue(x, name)
+}
+func (_XdrPtr_Entryplus3) XdrTypeName() string { return "Entryplus3*" }
+func (v _XdrPtr_Entryplus3) XdrPointer() interface{} { return v.p }
+func (v _XdrPtr_Entryplus3) XdrValue() interface{} { return *v.p }
+type XdrType_Entryplus3 = *Entryplus3
+func (v *Entryplus3) XdrPointer() interface{} { return v }
+func (Entryplus3) XdrTypeName() string { return "Entryplus3" }
+func (v Entryplus3) XdrValue() interface{} { return v }
+func (v *Entryplus3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Entryplus3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfileid", name), XDR_Fileid3(&v.Fileid))
+	x.Marshal(x.Sprintf("%sname", name), XDR_Filename3(&v.Name))
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Cookie3(&v.Cookie))
+	x.Marshal(x.Sprintf("%sname_attributes", name), XDR_Post_op_attr(&v.Name_attributes))
+	x.Marshal(x.Sprintf("%sname_handle", name), XDR_Post_op_fh3(&v.Name_handle))
+	x.Marshal(x.Sprintf("%snextentry", name), _XdrPtr_Entryplus3{&v.Nextentry})
+}
+func XDR_Entryplus3(v *Entryplus3) *Entryplus3 { return v }
+type XdrType_Dirlistplus3 = *Dirlistplus3
+func (v *Dirlistplus3) XdrPointer() interface{} { return v }
+func (Dirlistplus3) XdrTypeName() string { return "Dirlistplus3" }
+func (v Dirlistplus3) XdrValue() interface{} { return v }
+func (v *Dirlistplus3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Dirlistplus3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sentries", name), _XdrPtr_Entryplus3{&v.Entries})
+	x.Marshal(x.Sprintf("%seof", name), XDR_bool(&v.Eof))
+}
+func XDR_Dirlistplus3(v *Dirlistplus3) *Dirlistplus3 { return v }
+type XdrType_READDIRPLUS3resok = *READDIRPLUS3resok
+func (v *READDIRPLUS3resok) XdrPointer() interface{} { return v }
+func (READDIRPLUS3resok) XdrTypeName() string { return "READDIRPLUS3resok" }
+func (v READDIRPLUS3resok) XdrValue() interface{} { return v }
+func (v *READDIRPLUS3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READDIRPLUS3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_attributes", name), XDR_Post_op_attr(&v.Dir_attributes))
+	x.Marshal(x.Sprintf("%scookieverf", name), XDR_Cookieverf3(&v.Cookieverf))
+	x.Marshal(x.Sprintf("%sreply", name), XDR_Dirlistplus3(&v.Reply))
+}
+func XDR_READDIRPLUS3resok(v *READDIRPLUS3resok) *READDIRPLUS3resok { return v }
+type XdrType_READDIRPLUS3resfail = *READDIRPLUS3resfail
+func (v *READDIRPLUS3resfail) XdrPointer() interface{} { return v }
+func (READDIRPLUS3resfail) XdrTypeName() string { return "READDIRPLUS3resfail" }
+func (v READDIRPLUS3resfail) XdrValue() interface{} { return v }
+func (v *READDIRPLUS3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *READDIRPLUS3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sdir_attributes", name), XDR_Post_op_attr(&v.Dir_attributes))
+}
+func XDR_READDIRPLUS3resfail(v *READDIRPLUS3resfail) *READDIRPLUS3resfail { return v }
+func (_ READDIRPLUS3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *READDIRPLUS3res) Resok() *READDIRPLUS3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*READDIRPLUS3resok); ok {
+			return v
+		} else {
+			var zero READDIRPLUS3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("READDIRPLUS3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *READDIRPLUS3res) Resfail() *READDIRPLUS3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("READDIRPLUS3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*READDIRPLUS3resfail); ok {
+			return v
+		} else {
+			var zero READDIRPLUS3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u READDIRPLUS3res) XdrValid() bool {
+	return true
+}
+func (u *READDIRPLUS3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *READDIRPLUS3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *READDIRPLUS3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_READDIRPLUS3resok(u.Resok())
+	default:
+		return XDR_READDIRPLUS3resfail(u.Resfail())
+	}
+}
+func (u *READDIRPLUS3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_READDIRPLUS3res = *READDIRPLUS3res
+func (v *READDIRPLUS3res) XdrPointer() interface{} { return v }
+func (READDIRPLUS3res) XdrTypeName() string { return "READDIRPLUS3res" }
+func (v READDIRPLUS3res) XdrValue() interface{} { return v }
+func (v *READDIRPLUS3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *READDIRPLUS3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_READDIRPLUS3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_READDIRPLUS3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_READDIRPLUS3res(v *READDIRPLUS3res) *READDIRPLUS3res { return v}
+type XdrType_FSSTAT3args = *FSSTAT3args
+func (v *FSSTAT3args) XdrPointer() interface{} { return v }
+func (FSSTAT3args) XdrTypeName() string { return "FSSTAT3args" }
+func (v FSSTAT3args) XdrValue() interface{} { return v }
+func (v *FSSTAT3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *FSSTAT3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfsroot", name), XDR_Nfs_fh3(&v.Fsroot))
+}
+func XDR_FSSTAT3args(v *FSSTAT3args) *FSSTAT3args { return v }
+type XdrType_FSSTAT3resok = *FSSTAT3resok
+func (v *FSSTAT3resok) XdrPointer() interface{} { return v }
+func (FSSTAT3resok) XdrTypeName() string { return "FSSTAT3resok" }
+func (v FSSTAT3resok) XdrValue() interface{} { return v }
+func (v *FSSTAT3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *FSSTAT3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%stbytes", name), XDR_Size3(&v.Tbytes))
+	x.Marshal(x.Sprintf("%sfbytes", name), XDR_Size3(&v.Fbytes))
+	x.Marshal(x.Sprintf("%sabytes", name), XDR_Size3(&v.Abytes))
+	x.Marshal(x.Sprintf("%stfiles", name), XDR_Size3(&v.Tfiles))
+	x.Marshal(x.Sprintf("%sffiles", name), XDR_Size3(&v.Ffiles))
+	x.Marshal(x.Sprintf("%safiles", name), XDR_Size3(&v.Afiles))
+	x.Marshal(x.Sprintf("%sinvarsec", name), XDR_uint32(&v.Invarsec))
+}
+func XDR_FSSTAT3resok(v *FSSTAT3resok) *FSSTAT3resok { return v }
+type XdrType_FSSTAT3resfail = *FSSTAT3resfail
+func (v *FSSTAT3resfail) XdrPointer() interface{} { return v }
+func (FSSTAT3resfail) XdrTypeName() string { return "FSSTAT3resfail" }
+func (v FSSTAT3resfail) XdrValue() interface{} { return v }
+func (v *FSSTAT3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *FSSTAT3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+}
+func XDR_FSSTAT3resfail(v *FSSTAT3resfail) *FSSTAT3resfail { return v }
+func (_ FSSTAT3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *FSSTAT3res) Resok() *FSSTAT3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*FSSTAT3resok); ok {
+			return v
+		} else {
+			var zero FSSTAT3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("FSSTAT3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *FSSTAT3res) Resfail() *FSSTAT3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("FSSTAT3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*FSSTAT3resfail); ok {
+			return v
+		} else {
+			var zero FSSTAT3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u FSSTAT3res) XdrValid() bool {
+	return true
+}
+func (u *FSSTAT3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *FSSTAT3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *FSSTAT3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_FSSTAT3resok(u.Resok())
+	default:
+		return XDR_FSSTAT3resfail(u.Resfail())
+	}
+}
+func (u *FSSTAT3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_FSSTAT3res = *FSSTAT3res
+func (v *FSSTAT3res) XdrPointer() interface{} { return v }
+func (FSSTAT3res) XdrTypeName() string { return "FSSTAT3res" }
+func (v FSSTAT3res) XdrValue() interface{} { return v }
+func (v *FSSTAT3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *FSSTAT3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_FSSTAT3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_FSSTAT3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_FSSTAT3res(v *FSSTAT3res) *FSSTAT3res { return v}
+type XdrType_FSINFO3args = *FSINFO3args
+func (v *FSINFO3args) XdrPointer() interface{} { return v }
+func (FSINFO3args) XdrTypeName() string { return "FSINFO3args" }
+func (v FSINFO3args) XdrValue() interface{} { return v }
+func (v *FSINFO3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *FSINFO3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfsroot", name), XDR_Nfs_fh3(&v.Fsroot))
+}
+func XDR_FSINFO3args(v *FSINFO3args) *FSINFO3args { return v }
+type XdrType_FSINFO3resok = *FSINFO3resok
+func (v *FSINFO3resok) XdrPointer() interface{} { return v }
+func (FSINFO3resok) XdrTypeName() string { return "FSINFO3resok" }
+func (v FSINFO3resok) XdrValue() interface{} { return v }
+func (v *FSINFO3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *FSINFO3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%srtmax", name), XDR_uint32(&v.Rtmax))
+	x.Marshal(x.Sprintf("%srtpref", name), XDR_uint32(&v.Rtpref))
+	x.Marshal(x.Sprintf("%srtmult", name), XDR_uint32(&v.Rtmult))
+	x.Marshal(x.Sprintf("%swtmax", name), XDR_uint32(&v.Wtmax))
+	x.Marshal(x.Sprintf("%swtpref", name), XDR_uint32(&v.Wtpref))
+	x.Marshal(x.Sprintf("%swtmult", name), XDR_uint32(&v.Wtmult))
+	x.Marshal(x.Sprintf("%sdtpref", name), XDR_uint32(&v.Dtpref))
+	x.Marshal(x.Sprintf("%smaxfilesize", name), XDR_Size3(&v.Maxfilesize))
+	x.Marshal(x.Sprintf("%stime_delta", name), XDR_Nfstime3(&v.Time_delta))
+	x.Marshal(x.Sprintf("%sproperties", name), XDR_uint32(&v.Properties))
+}
+func XDR_FSINFO3resok(v *FSINFO3resok) *FSINFO3resok { return v }
+type XdrType_FSINFO3resfail = *FSINFO3resfail
+func (v *FSINFO3resfail) XdrPointer() interface{} { return v }
+func (FSINFO3resfail) XdrTypeName() string { return "FSINFO3resfail" }
+func (v FSINFO3resfail) XdrValue() interface{} { return v }
+func (v *FSINFO3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *FSINFO3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+}
+func XDR_FSINFO3resfail(v *FSINFO3resfail) *FSINFO3resfail { return v }
+func (_ FSINFO3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *FSINFO3res) Resok() *FSINFO3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*FSINFO3resok); ok {
+			return v
+		} else {
+			var zero FSINFO3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("FSINFO3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *FSINFO3res) Resfail() *FSINFO3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("FSINFO3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*FSINFO3resfail); ok {
+			return v
+		} else {
+			var zero FSINFO3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u FSINFO3res) XdrValid() bool {
+	return true
+}
+func (u *FSINFO3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *FSINFO3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *FSINFO3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_FSINFO3resok(u.Resok())
+	default:
+		return XDR_FSINFO3resfail(u.Resfail())
+	}
+}
+func (u *FSINFO3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_FSINFO3res = *FSINFO3res
+func (v *FSINFO3res) XdrPointer() interface{} { return v }
+func (FSINFO3res) XdrTypeName() string { return "FSINFO3res" }
+func (v FSINFO3res) XdrValue() interface{} { return v }
+func (v *FSINFO3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *FSINFO3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_FSINFO3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_FSINFO3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_FSINFO3res(v *FSINFO3res) *FSINFO3res { return v}
+type XdrType_PATHCONF3args = *PATHCONF3args
+func (v *PATHCONF3args) XdrPointer() interface{} { return v }
+func (PATHCONF3args) XdrTypeName() string { return "PATHCONF3args" }
+func (v PATHCONF3args) XdrValue() interface{} { return v }
+func (v *PATHCONF3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *PATHCONF3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobject", name), XDR_Nfs_fh3(&v.Object))
+}
+func XDR_PATHCONF3args(v *PATHCONF3args) *PATHCONF3args { return v }
+type XdrType_PATHCONF3resok = *PATHCONF3resok
+func (v *PATHCONF3resok) XdrPointer() interface{} { return v }
+func (PATHCONF3resok) XdrTypeName() string { return "PATHCONF3resok" }
+func (v PATHCONF3resok) XdrValue() interface{} { return v }
+func (v *PATHCONF3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *PATHCONF3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+	x.Marshal(x.Sprintf("%slinkmax", name), XDR_uint32(&v.Linkmax))
+	x.Marshal(x.Sprintf("%sname_max", name), XDR_uint32(&v.Name_max))
+	x.Marshal(x.Sprintf("%sno_trunc", name), XDR_bool(&v.No_trunc))
+	x.Marshal(x.Sprintf("%schown_restricted", name), XDR_bool(&v.Chown_restricted))
+	x.Marshal(x.Sprintf("%scase_insensitive", name), XDR_bool(&v.Case_insensitive))
+	x.Marshal(x.Sprintf("%scase_preserving", name), XDR_bool(&v.Case_preserving))
+}
+func XDR_PATHCONF3resok(v *PATHCONF3resok) *PATHCONF3resok { return v }
+type XdrType_PATHCONF3resfail = *PATHCONF3resfail
+func (v *PATHCONF3resfail) XdrPointer() interface{} { return v }
+func (PATHCONF3resfail) XdrTypeName() string { return "PATHCONF3resfail" }
+func (v PATHCONF3resfail) XdrValue() interface{} { return v }
+func (v *PATHCONF3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *PATHCONF3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sobj_attributes", name), XDR_Post_op_attr(&v.Obj_attributes))
+}
+func XDR_PATHCONF3resfail(v *PATHCONF3resfail) *PATHCONF3resfail { return v }
+func (_ PATHCONF3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *PATHCONF3res) Resok() *PATHCONF3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*PATHCONF3resok); ok {
+			return v
+		} else {
+			var zero PATHCONF3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("PATHCONF3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *PATHCONF3res) Resfail() *PATHCONF3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("PATHCONF3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*PATHCONF3resfail); ok {
+			return v
+		} else {
+			var zero PATHCONF3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u PATHCONF3res) XdrValid() bool {
+	return true
+}
+func (u *PATHCONF3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *PATHCONF3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *PATHCONF3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_PATHCONF3resok(u.Resok())
+	default:
+		return XDR_PATHCONF3resfail(u.Resfail())
+	}
+}
+func (u *PATHCONF3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_PATHCONF3res = *PATHCONF3res
+func (v *PATHCONF3res) XdrPointer() interface{} { return v }
+func (PATHCONF3res) XdrTypeName() string { return "PATHCONF3res" }
+func (v PATHCONF3res) XdrValue() interface{} { return v }
+func (v *PATHCONF3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *PATHCONF3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_PATHCONF3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_PATHCONF3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_PATHCONF3res(v *PATHCONF3res) *PATHCONF3res { return v}
+type XdrType_COMMIT3args = *COMMIT3args
+func (v *COMMIT3args) XdrPointer() interface{} { return v }
+func (COMMIT3args) XdrTypeName() string { return "COMMIT3args" }
+func (v COMMIT3args) XdrValue() interface{} { return v }
+func (v *COMMIT3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *COMMIT3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile", name), XDR_Nfs_fh3(&v.File))
+	x.Marshal(x.Sprintf("%soffset", name), XDR_Offset3(&v.Offset))
+	x.Marshal(x.Sprintf("%scount", name), XDR_Count3(&v.Count))
+}
+func XDR_COMMIT3args(v *COMMIT3args) *COMMIT3args { return v }
+type XdrType_COMMIT3resok = *COMMIT3resok
+func (v *COMMIT3resok) XdrPointer() interface{} { return v }
+func (COMMIT3resok) XdrTypeName() string { return "COMMIT3resok" }
+func (v COMMIT3resok) XdrValue() interface{} { return v }
+func (v *COMMIT3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *COMMIT3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile_wcc", name), XDR_Wcc_data(&v.File_wcc))
+	x.Marshal(x.Sprintf("%sverf", name), XDR_Writeverf3(&v.Verf))
+}
+func XDR_COMMIT3resok(v *COMMIT3resok) *COMMIT3resok { return v }
+type XdrType_COMMIT3resfail = *COMMIT3resfail
+func (v *COMMIT3resfail) XdrPointer() interface{} { return v }
+func (COMMIT3resfail) XdrTypeName() string { return "COMMIT3resfail" }
+func (v COMMIT3resfail) XdrValue() interface{} { return v }
+func (v *COMMIT3resfail) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *COMMIT3resfail) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfile_wcc", name), XDR_Wcc_data(&v.File_wcc))
+}
+func XDR_COMMIT3resfail(v *COMMIT3resfail) *COMMIT3resfail { return v }
+func (_ COMMIT3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *COMMIT3res) Resok() *COMMIT3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*COMMIT3resok); ok {
+			return v
+		} else {
+			var zero COMMIT3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("COMMIT3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *COMMIT3res) Resfail() *COMMIT3resfail {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("COMMIT3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*COMMIT3resfail); ok {
+			return v
+		} else {
+			var zero COMMIT3resfail
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u COMMIT3res) XdrValid() bool {
+	return true
+}
+func (u *COMMIT3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *COMMIT3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *COMMIT3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_COMMIT3resok(u.Resok())
+	default:
+		return XDR_COMMIT3resfail(u.Resfail())
+	}
+}
+func (u *COMMIT3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_COMMIT3res = *COMMIT3res
+func (v *COMMIT3res) XdrPointer() interface{} { return v }
+func (COMMIT3res) XdrTypeName() string { return "COMMIT3res" }
+func (v COMMIT3res) XdrValue() interface{} { return v }
+func (v *COMMIT3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *COMMIT3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_COMMIT3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_COMMIT3resfail(u.Resfail()))
+		return
+	}
+}
+func XDR_COMMIT3res(v *COMMIT3res) *COMMIT3res { return v}
+
+type XdrProc_NFSPROC3_NULL struct {
+	Arg *XdrVoid
+	Res *XdrVoid
+}
+func (XdrProc_NFSPROC3_NULL) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_NULL) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_NULL) Proc() uint32 { return 0 }
+func (XdrProc_NFSPROC3_NULL) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_NULL) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_NULL) ProcName() string { return "NFSPROC3_NULL" }
+func (p *XdrProc_NFSPROC3_NULL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_NULL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_NULL{} // XXX
+
+type xdrSrvProc_NFSPROC3_NULL struct {
+	XdrProc_NFSPROC3_NULL
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_NULL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_NULL) Do() {
+	p.Srv.NFSPROC3_NULL()
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_NULL{} // XXX
+
+type XdrProc_NFSPROC3_GETATTR struct {
+	Arg *GETATTR3args
+	Res *GETATTR3res
+}
+func (XdrProc_NFSPROC3_GETATTR) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_GETATTR) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_GETATTR) Proc() uint32 { return 1 }
+func (XdrProc_NFSPROC3_GETATTR) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_GETATTR) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_GETATTR) ProcName() string { return "NFSPROC3_GETATTR" }
+func (p *XdrProc_NFSPROC3_GETATTR) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(GETATTR3args)
+	}
+	return XDR_GETATTR3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_GETATTR) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(GETATTR3res)
+	}
+	return XDR_GETATTR3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_GETATTR{} // XXX
+
+type xdrSrvProc_NFSPROC3_GETATTR struct {
+	XdrProc_NFSPROC3_GETATTR
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_GETATTR) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_GETATTR) Do() {
+	r := p.Srv.NFSPROC3_GETATTR(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_GETATTR{} // XXX
+
+type XdrProc_NFSPROC3_SETATTR struct {
+	Arg *SETATTR3args
+	Res *SETATTR3res
+}
+func (XdrProc_NFSPROC3_SETATTR) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_SETATTR) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_SETATTR) Proc() uint32 { return 2 }
+func (XdrProc_NFSPROC3_SETATTR) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_SETATTR) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_SETATTR) ProcName() string { return "NFSPROC3_SETATTR" }
+func (p *XdrProc_NFSPROC3_SETATTR) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(SETATTR3args)
+	}
+	return XDR_SETATTR3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_SETATTR) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(SETATTR3res)
+	}
+	return XDR_SETATTR3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_SETATTR{} // XXX
+
+type xdrSrvProc_NFSPROC3_SETATTR struct {
+	XdrProc_NFSPROC3_SETATTR
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_SETATTR) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_SETATTR) Do() {
+	r := p.Srv.NFSPROC3_SETATTR(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_SETATTR{} // XXX
+
+type XdrProc_NFSPROC3_LOOKUP struct {
+	Arg *LOOKUP3args
+	Res *LOOKUP3res
+}
+func (XdrProc_NFSPROC3_LOOKUP) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_LOOKUP) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_LOOKUP) Proc() uint32 { return 3 }
+func (XdrProc_NFSPROC3_LOOKUP) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_LOOKUP) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_LOOKUP) ProcName() string { return "NFSPROC3_LOOKUP" }
+func (p *XdrProc_NFSPROC3_LOOKUP) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(LOOKUP3args)
+	}
+	return XDR_LOOKUP3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_LOOKUP) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(LOOKUP3res)
+	}
+	return XDR_LOOKUP3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_LOOKUP{} // XXX
+
+type xdrSrvProc_NFSPROC3_LOOKUP struct {
+	XdrProc_NFSPROC3_LOOKUP
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_LOOKUP) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_LOOKUP) Do() {
+	r := p.Srv.NFSPROC3_LOOKUP(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_LOOKUP{} // XXX
+
+type XdrProc_NFSPROC3_ACCESS struct {
+	Arg *ACCESS3args
+	Res *ACCESS3res
+}
+func (XdrProc_NFSPROC3_ACCESS) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_ACCESS) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_ACCESS) Proc() uint32 { return 4 }
+func (XdrProc_NFSPROC3_ACCESS) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_ACCESS) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_ACCESS) ProcName() string { return "NFSPROC3_ACCESS" }
+func (p *XdrProc_NFSPROC3_ACCESS) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(ACCESS3args)
+	}
+	return XDR_ACCESS3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_ACCESS) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(ACCESS3res)
+	}
+	return XDR_ACCESS3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_ACCESS{} // XXX
+
+type xdrSrvProc_NFSPROC3_ACCESS struct {
+	XdrProc_NFSPROC3_ACCESS
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_ACCESS) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_ACCESS) Do() {
+	r := p.Srv.NFSPROC3_ACCESS(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_ACCESS{} // XXX
+
+type XdrProc_NFSPROC3_READLINK struct {
+	Arg *READLINK3args
+	Res *READLINK3res
+}
+func (XdrProc_NFSPROC3_READLINK) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_READLINK) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_READLINK) Proc() uint32 { return 5 }
+func (XdrProc_NFSPROC3_READLINK) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_READLINK) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_READLINK) ProcName() string { return "NFSPROC3_READLINK" }
+func (p *XdrProc_NFSPROC3_READLINK) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(READLINK3args)
+	}
+	return XDR_READLINK3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_READLINK) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(READLINK3res)
+	}
+	return XDR_READLINK3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_READLINK{} // XXX
+
+type xdrSrvProc_NFSPROC3_READLINK struct {
+	XdrProc_NFSPROC3_READLINK
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_READLINK) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_READLINK) Do() {
+	r := p.Srv.NFSPROC3_READLINK(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_READLINK{} // XXX
+
+type XdrProc_NFSPROC3_READ struct {
+	Arg *READ3args
+	Res *READ3res
+}
+func (XdrProc_NFSPROC3_READ) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_READ) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_READ) Proc() uint32 { return 6 }
+func (XdrProc_NFSPROC3_READ) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_READ) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_READ) ProcName() string { return "NFSPROC3_READ" }
+func (p *XdrProc_NFSPROC3_READ) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(READ3args)
+	}
+	return XDR_READ3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_READ) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(READ3res)
+	}
+	return XDR_READ3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_READ{} // XXX
+
+type xdrSrvProc_NFSPROC3_READ struct {
+	XdrProc_NFSPROC3_READ
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_READ) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_READ) Do() {
+	r := p.Srv.NFSPROC3_READ(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_READ{} // XXX
+
+type XdrProc_NFSPROC3_WRITE struct {
+	Arg *WRITE3args
+	Res *WRITE3res
+}
+func (XdrProc_NFSPROC3_WRITE) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_WRITE) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_WRITE) Proc() uint32 { return 7 }
+func (XdrProc_NFSPROC3_WRITE) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_WRITE) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_WRITE) ProcName() string { return "NFSPROC3_WRITE" }
+func (p *XdrProc_NFSPROC3_WRITE) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(WRITE3args)
+	}
+	return XDR_WRITE3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_WRITE) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(WRITE3res)
+	}
+	return XDR_WRITE3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_WRITE{} // XXX
+
+type xdrSrvProc_NFSPROC3_WRITE struct {
+	XdrProc_NFSPROC3_WRITE
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_WRITE) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_WRITE) Do() {
+	r := p.Srv.NFSPROC3_WRITE(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_WRITE{} // XXX
+
+type XdrProc_NFSPROC3_CREATE struct {
+	Arg *CREATE3args
+	Res *CREATE3res
+}
+func (XdrProc_NFSPROC3_CREATE) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_CREATE) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_CREATE) Proc() uint32 { return 8 }
+func (XdrProc_NFSPROC3_CREATE) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_CREATE) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_CREATE) ProcName() string { return "NFSPROC3_CREATE" }
+func (p *XdrProc_NFSPROC3_CREATE) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(CREATE3args)
+	}
+	return XDR_CREATE3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_CREATE) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(CREATE3res)
+	}
+	return XDR_CREATE3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_CREATE{} // XXX
+
+type xdrSrvProc_NFSPROC3_CREATE struct {
+	XdrProc_NFSPROC3_CREATE
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_CREATE) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_CREATE) Do() {
+	r := p.Srv.NFSPROC3_CREATE(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_CREATE{} // XXX
+
+type XdrProc_NFSPROC3_MKDIR struct {
+	Arg *MKDIR3args
+	Res *MKDIR3res
+}
+func (XdrProc_NFSPROC3_MKDIR) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_MKDIR) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_MKDIR) Proc() uint32 { return 9 }
+func (XdrProc_NFSPROC3_MKDIR) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_MKDIR) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_MKDIR) ProcName() string { return "NFSPROC3_MKDIR" }
+func (p *XdrProc_NFSPROC3_MKDIR) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(MKDIR3args)
+	}
+	return XDR_MKDIR3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_MKDIR) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(MKDIR3res)
+	}
+	return XDR_MKDIR3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_MKDIR{} // XXX
+
+type xdrSrvProc_NFSPROC3_MKDIR struct {
+	XdrProc_NFSPROC3_MKDIR
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_MKDIR) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_MKDIR) Do() {
+	r := p.Srv.NFSPROC3_MKDIR(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_MKDIR{} // XXX
+
+type XdrProc_NFSPROC3_SYMLINK struct {
+	Arg *SYMLINK3args
+	Res *SYMLINK3res
+}
+func (XdrProc_NFSPROC3_SYMLINK) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_SYMLINK) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_SYMLINK) Proc() uint32 { return 10 }
+func (XdrProc_NFSPROC3_SYMLINK) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_SYMLINK) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_SYMLINK) ProcName() string { return "NFSPROC3_SYMLINK" }
+func (p *XdrProc_NFSPROC3_SYMLINK) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(SYMLINK3args)
+	}
+	return XDR_SYMLINK3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_SYMLINK) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(SYMLINK3res)
+	}
+	return XDR_SYMLINK3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_SYMLINK{} // XXX
+
+type xdrSrvProc_NFSPROC3_SYMLINK struct {
+	XdrProc_NFSPROC3_SYMLINK
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_SYMLINK) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_SYMLINK) Do() {
+	r := p.Srv.NFSPROC3_SYMLINK(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_SYMLINK{} // XXX
+
+type XdrProc_NFSPROC3_MKNOD struct {
+	Arg *MKNOD3args
+	Res *MKNOD3res
+}
+func (XdrProc_NFSPROC3_MKNOD) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_MKNOD) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_MKNOD) Proc() uint32 { return 11 }
+func (XdrProc_NFSPROC3_MKNOD) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_MKNOD) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_MKNOD) ProcName() string { return "NFSPROC3_MKNOD" }
+func (p *XdrProc_NFSPROC3_MKNOD) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(MKNOD3args)
+	}
+	return XDR_MKNOD3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_MKNOD) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(MKNOD3res)
+	}
+	return XDR_MKNOD3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_MKNOD{} // XXX
+
+type xdrSrvProc_NFSPROC3_MKNOD struct {
+	XdrProc_NFSPROC3_MKNOD
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_MKNOD) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_MKNOD) Do() {
+	r := p.Srv.NFSPROC3_MKNOD(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_MKNOD{} // XXX
+
+type XdrProc_NFSPROC3_REMOVE struct {
+	Arg *REMOVE3args
+	Res *REMOVE3res
+}
+func (XdrProc_NFSPROC3_REMOVE) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_REMOVE) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_REMOVE) Proc() uint32 { return 12 }
+func (XdrProc_NFSPROC3_REMOVE) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_REMOVE) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_REMOVE) ProcName() string { return "NFSPROC3_REMOVE" }
+func (p *XdrProc_NFSPROC3_REMOVE) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(REMOVE3args)
+	}
+	return XDR_REMOVE3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_REMOVE) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(REMOVE3res)
+	}
+	return XDR_REMOVE3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_REMOVE{} // XXX
+
+type xdrSrvProc_NFSPROC3_REMOVE struct {
+	XdrProc_NFSPROC3_REMOVE
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_REMOVE) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_REMOVE) Do() {
+	r := p.Srv.NFSPROC3_REMOVE(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_REMOVE{} // XXX
+
+type XdrProc_NFSPROC3_RMDIR struct {
+	Arg *RMDIR3args
+	Res *RMDIR3res
+}
+func (XdrProc_NFSPROC3_RMDIR) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_RMDIR) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_RMDIR) Proc() uint32 { return 13 }
+func (XdrProc_NFSPROC3_RMDIR) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_RMDIR) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_RMDIR) ProcName() string { return "NFSPROC3_RMDIR" }
+func (p *XdrProc_NFSPROC3_RMDIR) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(RMDIR3args)
+	}
+	return XDR_RMDIR3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_RMDIR) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(RMDIR3res)
+	}
+	return XDR_RMDIR3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_RMDIR{} // XXX
+
+type xdrSrvProc_NFSPROC3_RMDIR struct {
+	XdrProc_NFSPROC3_RMDIR
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_RMDIR) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_RMDIR) Do() {
+	r := p.Srv.NFSPROC3_RMDIR(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_RMDIR{} // XXX
+
+type XdrProc_NFSPROC3_RENAME struct {
+	Arg *RENAME3args
+	Res *RENAME3res
+}
+func (XdrProc_NFSPROC3_RENAME) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_RENAME) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_RENAME) Proc() uint32 { return 14 }
+func (XdrProc_NFSPROC3_RENAME) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_RENAME) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_RENAME) ProcName() string { return "NFSPROC3_RENAME" }
+func (p *XdrProc_NFSPROC3_RENAME) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(RENAME3args)
+	}
+	return XDR_RENAME3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_RENAME) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(RENAME3res)
+	}
+	return XDR_RENAME3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_RENAME{} // XXX
+
+type xdrSrvProc_NFSPROC3_RENAME struct {
+	XdrProc_NFSPROC3_RENAME
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_RENAME) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_RENAME) Do() {
+	r := p.Srv.NFSPROC3_RENAME(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_RENAME{} // XXX
+
+type XdrProc_NFSPROC3_LINK struct {
+	Arg *LINK3args
+	Res *LINK3res
+}
+func (XdrProc_NFSPROC3_LINK) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_LINK) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_LINK) Proc() uint32 { return 15 }
+func (XdrProc_NFSPROC3_LINK) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_LINK) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_LINK) ProcName() string { return "NFSPROC3_LINK" }
+func (p *XdrProc_NFSPROC3_LINK) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(LINK3args)
+	}
+	return XDR_LINK3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_LINK) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(LINK3res)
+	}
+	return XDR_LINK3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_LINK{} // XXX
+
+type xdrSrvProc_NFSPROC3_LINK struct {
+	XdrProc_NFSPROC3_LINK
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_LINK) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_LINK) Do() {
+	r := p.Srv.NFSPROC3_LINK(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_LINK{} // XXX
+
+type XdrProc_NFSPROC3_READDIR struct {
+	Arg *READDIR3args
+	Res *READDIR3res
+}
+func (XdrProc_NFSPROC3_READDIR) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_READDIR) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_READDIR) Proc() uint32 { return 16 }
+func (XdrProc_NFSPROC3_READDIR) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_READDIR) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_READDIR) ProcName() string { return "NFSPROC3_READDIR" }
+func (p *XdrProc_NFSPROC3_READDIR) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(READDIR3args)
+	}
+	return XDR_READDIR3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_READDIR) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(READDIR3res)
+	}
+	return XDR_READDIR3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_READDIR{} // XXX
+
+type xdrSrvProc_NFSPROC3_READDIR struct {
+	XdrProc_NFSPROC3_READDIR
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_READDIR) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_READDIR) Do() {
+	r := p.Srv.NFSPROC3_READDIR(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_READDIR{} // XXX
+
+type XdrProc_NFSPROC3_READDIRPLUS struct {
+	Arg *READDIRPLUS3args
+	Res *READDIRPLUS3res
+}
+func (XdrProc_NFSPROC3_READDIRPLUS) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_READDIRPLUS) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_READDIRPLUS) Proc() uint32 { return 17 }
+func (XdrProc_NFSPROC3_READDIRPLUS) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_READDIRPLUS) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_READDIRPLUS) ProcName() string { return "NFSPROC3_READDIRPLUS" }
+func (p *XdrProc_NFSPROC3_READDIRPLUS) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(READDIRPLUS3args)
+	}
+	return XDR_READDIRPLUS3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_READDIRPLUS) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(READDIRPLUS3res)
+	}
+	return XDR_READDIRPLUS3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_READDIRPLUS{} // XXX
+
+type xdrSrvProc_NFSPROC3_READDIRPLUS struct {
+	XdrProc_NFSPROC3_READDIRPLUS
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_READDIRPLUS) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_READDIRPLUS) Do() {
+	r := p.Srv.NFSPROC3_READDIRPLUS(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_READDIRPLUS{} // XXX
+
+type XdrProc_NFSPROC3_FSSTAT struct {
+	Arg *FSSTAT3args
+	Res *FSSTAT3res
+}
+func (XdrProc_NFSPROC3_FSSTAT) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_FSSTAT) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_FSSTAT) Proc() uint32 { return 18 }
+func (XdrProc_NFSPROC3_FSSTAT) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_FSSTAT) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_FSSTAT) ProcName() string { return "NFSPROC3_FSSTAT" }
+func (p *XdrProc_NFSPROC3_FSSTAT) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(FSSTAT3args)
+	}
+	return XDR_FSSTAT3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_FSSTAT) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(FSSTAT3res)
+	}
+	return XDR_FSSTAT3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_FSSTAT{} // XXX
+
+type xdrSrvProc_NFSPROC3_FSSTAT struct {
+	XdrProc_NFSPROC3_FSSTAT
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_FSSTAT) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_FSSTAT) Do() {
+	r := p.Srv.NFSPROC3_FSSTAT(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_FSSTAT{} // XXX
+
+type XdrProc_NFSPROC3_FSINFO struct {
+	Arg *FSINFO3args
+	Res *FSINFO3res
+}
+func (XdrProc_NFSPROC3_FSINFO) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_FSINFO) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_FSINFO) Proc() uint32 { return 19 }
+func (XdrProc_NFSPROC3_FSINFO) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_FSINFO) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_FSINFO) ProcName() string { return "NFSPROC3_FSINFO" }
+func (p *XdrProc_NFSPROC3_FSINFO) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(FSINFO3args)
+	}
+	return XDR_FSINFO3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_FSINFO) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(FSINFO3res)
+	}
+	return XDR_FSINFO3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_FSINFO{} // XXX
+
+type xdrSrvProc_NFSPROC3_FSINFO struct {
+	XdrProc_NFSPROC3_FSINFO
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_FSINFO) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_FSINFO) Do() {
+	r := p.Srv.NFSPROC3_FSINFO(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_FSINFO{} // XXX
+
+type XdrProc_NFSPROC3_PATHCONF struct {
+	Arg *PATHCONF3args
+	Res *PATHCONF3res
+}
+func (XdrProc_NFSPROC3_PATHCONF) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_PATHCONF) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_PATHCONF) Proc() uint32 { return 20 }
+func (XdrProc_NFSPROC3_PATHCONF) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_PATHCONF) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_PATHCONF) ProcName() string { return "NFSPROC3_PATHCONF" }
+func (p *XdrProc_NFSPROC3_PATHCONF) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(PATHCONF3args)
+	}
+	return XDR_PATHCONF3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_PATHCONF) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(PATHCONF3res)
+	}
+	return XDR_PATHCONF3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_PATHCONF{} // XXX
+
+type xdrSrvProc_NFSPROC3_PATHCONF struct {
+	XdrProc_NFSPROC3_PATHCONF
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_PATHCONF) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_PATHCONF) Do() {
+	r := p.Srv.NFSPROC3_PATHCONF(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_PATHCONF{} // XXX
+
+type XdrProc_NFSPROC3_COMMIT struct {
+	Arg *COMMIT3args
+	Res *COMMIT3res
+}
+func (XdrProc_NFSPROC3_COMMIT) Prog() uint32 { return 100003 }
+func (XdrProc_NFSPROC3_COMMIT) Vers() uint32 { return 3 }
+func (XdrProc_NFSPROC3_COMMIT) Proc() uint32 { return 21 }
+func (XdrProc_NFSPROC3_COMMIT) ProgName() string { return "NFS_PROGRAM" }
+func (XdrProc_NFSPROC3_COMMIT) VersName() string { return "NFS_V3" }
+func (XdrProc_NFSPROC3_COMMIT) ProcName() string { return "NFSPROC3_COMMIT" }
+func (p *XdrProc_NFSPROC3_COMMIT) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(COMMIT3args)
+	}
+	return XDR_COMMIT3args(p.Arg)
+}
+func (p *XdrProc_NFSPROC3_COMMIT) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(COMMIT3res)
+	}
+	return XDR_COMMIT3res(p.Res)
+}
+var _ XdrProc = &XdrProc_NFSPROC3_COMMIT{} // XXX
+
+type xdrSrvProc_NFSPROC3_COMMIT struct {
+	XdrProc_NFSPROC3_COMMIT
+	Srv NFS_V3
+}
+func (p *xdrSrvProc_NFSPROC3_COMMIT) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NFSPROC3_COMMIT) Do() {
+	r := p.Srv.NFSPROC3_COMMIT(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NFSPROC3_COMMIT{} // XXX
+
+func init() {
+	XdrCatalog[100003<<32|3] = func(p uint32) XdrProc {
+		switch(p) {
+		case 0:
+			return &XdrProc_NFSPROC3_NULL{}
+		case 1:
+			return &XdrProc_NFSPROC3_GETATTR{}
+		case 2:
+			return &XdrProc_NFSPROC3_SETATTR{}
+		case 3:
+			return &XdrProc_NFSPROC3_LOOKUP{}
+		case 4:
+			return &XdrProc_NFSPROC3_ACCESS{}
+		case 5:
+			return &XdrProc_NFSPROC3_READLINK{}
+		case 6:
+			return &XdrProc_NFSPROC3_READ{}
+		case 7:
+			return &XdrProc_NFSPROC3_WRITE{}
+		case 8:
+			return &XdrProc_NFSPROC3_CREATE{}
+		case 9:
+			return &XdrProc_NFSPROC3_MKDIR{}
+		case 10:
+			return &XdrProc_NFSPROC3_SYMLINK{}
+		case 11:
+			return &XdrProc_NFSPROC3_MKNOD{}
+		case 12:
+			return &XdrProc_NFSPROC3_REMOVE{}
+		case 13:
+			return &XdrProc_NFSPROC3_RMDIR{}
+		case 14:
+			return &XdrProc_NFSPROC3_RENAME{}
+		case 15:
+			return &XdrProc_NFSPROC3_LINK{}
+		case 16:
+			return &XdrProc_NFSPROC3_READDIR{}
+		case 17:
+			return &XdrProc_NFSPROC3_READDIRPLUS{}
+		case 18:
+			return &XdrProc_NFSPROC3_FSSTAT{}
+		case 19:
+			return &XdrProc_NFSPROC3_FSINFO{}
+		case 20:
+			return &XdrProc_NFSPROC3_PATHCONF{}
+		case 21:
+			return &XdrProc_NFSPROC3_COMMIT{}
+		}
+		return nil
+	}
+}
+
+type NFS_V3_Server struct {
+	Srv NFS_V3
+}
+func (NFS_V3_Server) Prog() uint32 { return 100003 }
+func (NFS_V3_Server) Vers() uint32 { return 3 }
+func (NFS_V3_Server) ProgName() string { return "NFS_PROGRAM" }
+func (NFS_V3_Server) VersName() string { return "NFS_V3" }
+func (s NFS_V3_Server) GetProc(p uint32) XdrSrvProc {
+	switch p {
+	case 0:  // NFSPROC3_NULL
+		return &xdrSrvProc_NFSPROC3_NULL{ Srv: s.Srv }
+	case 1:  // NFSPROC3_GETATTR
+		return &xdrSrvProc_NFSPROC3_GETATTR{ Srv: s.Srv }
+	case 2:  // NFSPROC3_SETATTR
+		return &xdrSrvProc_NFSPROC3_SETATTR{ Srv: s.Srv }
+	case 3:  // NFSPROC3_LOOKUP
+		return &xdrSrvProc_NFSPROC3_LOOKUP{ Srv: s.Srv }
+	case 4:  // NFSPROC3_ACCESS
+		return &xdrSrvProc_NFSPROC3_ACCESS{ Srv: s.Srv }
+	case 5:  // NFSPROC3_READLINK
+		return &xdrSrvProc_NFSPROC3_READLINK{ Srv: s.Srv }
+	case 6:  // NFSPROC3_READ
+		return &xdrSrvProc_NFSPROC3_READ{ Srv: s.Srv }
+	case 7:  // NFSPROC3_WRITE
+		return &xdrSrvProc_NFSPROC3_WRITE{ Srv: s.Srv }
+	case 8:  // NFSPROC3_CREATE
+		return &xdrSrvProc_NFSPROC3_CREATE{ Srv: s.Srv }
+	case 9:  // NFSPROC3_MKDIR
+		return &xdrSrvProc_NFSPROC3_MKDIR{ Srv: s.Srv }
+	case 10:  // NFSPROC3_SYMLINK
+		return &xdrSrvProc_NFSPROC3_SYMLINK{ Srv: s.Srv }
+	case 11:  // NFSPROC3_MKNOD
+		return &xdrSrvProc_NFSPROC3_MKNOD{ Srv: s.Srv }
+	case 12:  // NFSPROC3_REMOVE
+		return &xdrSrvProc_NFSPROC3_REMOVE{ Srv: s.Srv }
+	case 13:  // NFSPROC3_RMDIR
+		return &xdrSrvProc_NFSPROC3_RMDIR{ Srv: s.Srv }
+	case 14:  // NFSPROC3_RENAME
+		return &xdrSrvProc_NFSPROC3_RENAME{ Srv: s.Srv }
+	case 15:  // NFSPROC3_LINK
+		return &xdrSrvProc_NFSPROC3_LINK{ Srv: s.Srv }
+	case 16:  // NFSPROC3_READDIR
+		return &xdrSrvProc_NFSPROC3_READDIR{ Srv: s.Srv }
+	case 17:  // NFSPROC3_READDIRPLUS
+		return &xdrSrvProc_NFSPROC3_READDIRPLUS{ Srv: s.Srv }
+	case 18:  // NFSPROC3_FSSTAT
+		return &xdrSrvProc_NFSPROC3_FSSTAT{ Srv: s.Srv }
+	case 19:  // NFSPROC3_FSINFO
+		return &xdrSrvProc_NFSPROC3_FSINFO{ Srv: s.Srv }
+	case 20:  // NFSPROC3_PATHCONF
+		return &xdrSrvProc_NFSPROC3_PATHCONF{ Srv: s.Srv }
+	case 21:  // NFSPROC3_COMMIT
+		return &xdrSrvProc_NFSPROC3_COMMIT{ Srv: s.Srv }
+	default:
+		return nil
+	}
+}
+var _ XdrSrv = NFS_V3_Server{} // XXX
+
+type NFS_V3_Client struct {
+	Send XdrSendCall
+	Ctx context.Context
+}
+var _ NFS_V3 = NFS_V3_Client{} // XXX
+func (c NFS_V3_Client) WithContext(ctx context.Context) NFS_V3 {
+	c.Ctx = ctx
+	return c
+}
+func (c NFS_V3_Client) NFSPROC3_NULL() {
+	var proc XdrProc_NFSPROC3_NULL
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NFS_V3_Client) NFSPROC3_GETATTR(a1 GETATTR3args) GETATTR3res {
+	var proc XdrProc_NFSPROC3_GETATTR
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_SETATTR(a1 SETATTR3args) SETATTR3res {
+	var proc XdrProc_NFSPROC3_SETATTR
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_LOOKUP(a1 LOOKUP3args) LOOKUP3res {
+	var proc XdrProc_NFSPROC3_LOOKUP
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_ACCESS(a1 ACCESS3args) ACCESS3res {
+	var proc XdrProc_NFSPROC3_ACCESS
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_READLINK(a1 READLINK3args) READLINK3res {
+	var proc XdrProc_NFSPROC3_READLINK
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_READ(a1 READ3args) READ3res {
+	var proc XdrProc_NFSPROC3_READ
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_WRITE(a1 WRITE3args) WRITE3res {
+	var proc XdrProc_NFSPROC3_WRITE
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_CREATE(a1 CREATE3args) CREATE3res {
+	var proc XdrProc_NFSPROC3_CREATE
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_MKDIR(a1 MKDIR3args) MKDIR3res {
+	var proc XdrProc_NFSPROC3_MKDIR
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_SYMLINK(a1 SYMLINK3args) SYMLINK3res {
+	var proc XdrProc_NFSPROC3_SYMLINK
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_MKNOD(a1 MKNOD3args) MKNOD3res {
+	var proc XdrProc_NFSPROC3_MKNOD
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_REMOVE(a1 REMOVE3args) REMOVE3res {
+	var proc XdrProc_NFSPROC3_REMOVE
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_RMDIR(a1 RMDIR3args) RMDIR3res {
+	var proc XdrProc_NFSPROC3_RMDIR
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_RENAME(a1 RENAME3args) RENAME3res {
+	var proc XdrProc_NFSPROC3_RENAME
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_LINK(a1 LINK3args) LINK3res {
+	var proc XdrProc_NFSPROC3_LINK
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_READDIR(a1 READDIR3args) READDIR3res {
+	var proc XdrProc_NFSPROC3_READDIR
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_READDIRPLUS(a1 READDIRPLUS3args) READDIRPLUS3res {
+	var proc XdrProc_NFSPROC3_READDIRPLUS
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_FSSTAT(a1 FSSTAT3args) FSSTAT3res {
+	var proc XdrProc_NFSPROC3_FSSTAT
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_FSINFO(a1 FSINFO3args) FSINFO3res {
+	var proc XdrProc_NFSPROC3_FSINFO
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_PATHCONF(a1 PATHCONF3args) PATHCONF3res {
+	var proc XdrProc_NFSPROC3_PATHCONF
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_V3_Client) NFSPROC3_COMMIT(a1 COMMIT3args) COMMIT3res {
+	var proc XdrProc_NFSPROC3_COMMIT
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+type XdrType_Fhandle3 struct {
+	XdrVecOpaque
+}
+func XDR_Fhandle3(v *Fhandle3) XdrType_Fhandle3 {
+	return XdrType_Fhandle3{XdrVecOpaque{v, FHSIZE3}}
+}
+func (XdrType_Fhandle3) XdrTypeName() string { return "Fhandle3" }
+func (v XdrType_Fhandle3) XdrUnwrap() XdrType { return v.XdrVecOpaque }
+type XdrType_Dirpath struct {
+	XdrString
+}
+func XDR_Dirpath(v *Dirpath) XdrType_Dirpath {
+	return XdrType_Dirpath{XdrString{v, MNTPATHLEN}}
+}
+func (XdrType_Dirpath) XdrTypeName() string { return "Dirpath" }
+func (v XdrType_Dirpath) XdrUnwrap() XdrType { return v.XdrString }
+type XdrType_Name struct {
+	XdrString
+}
+func XDR_Name(v *Name) XdrType_Name {
+	return XdrType_Name{XdrString{v, MNTNAMLEN}}
+}
+func (XdrType_Name) XdrTypeName() string { return "Name" }
+func (v XdrType_Name) XdrUnwrap() XdrType { return v.XdrString }
+var _XdrNames_Mountstat3 = map[int32]string{
+	int32(MNT3_OK): "MNT3_OK",
+	int32(MNT3ERR_PERM): "MNT3ERR_PERM",
+	int32(MNT3ERR_NOENT): "MNT3ERR_NOENT",
+	int32(MNT3ERR_IO): "MNT3ERR_IO",
+	int32(MNT3ERR_ACCES): "MNT3ERR_ACCES",
+	int32(MNT3ERR_NOTDIR): "MNT3ERR_NOTDIR",
+	int32(MNT3ERR_INVAL): "MNT3ERR_INVAL",
+	int32(MNT3ERR_NAMETOOLONG): "MNT3ERR_NAMETOOLONG",
+	int32(MNT3ERR_NOTSUPP): "MNT3ERR_NOTSUPP",
+	int32(MNT3ERR_SERVERFAULT): "MNT3ERR_SERVERFAULT",
+}
+var _XdrValues_Mountstat3 = map[string]int32{
+	"MNT3_OK": int32(MNT3_OK),
+	"MNT3ERR_PERM": int32(MNT3ERR_PERM),
+	"MNT3ERR_NOENT": int32(MNT3ERR_NOENT),
+	"MNT3ERR_IO": int32(MNT3ERR_IO),
+	"MNT3ERR_ACCES": int32(MNT3ERR_ACCES),
+	"MNT3ERR_NOTDIR": int32(MNT3ERR_NOTDIR),
+	"MNT3ERR_INVAL": int32(MNT3ERR_INVAL),
+	"MNT3ERR_NAMETOOLONG": int32(MNT3ERR_NAMETOOLONG),
+	"MNT3ERR_NOTSUPP": int32(MNT3ERR_NOTSUPP),
+	"MNT3ERR_SERVERFAULT": int32(MNT3ERR_SERVERFAULT),
+}
+func (Mountstat3) XdrEnumNames() map[int32]string {
+	return _XdrNames_Mountstat3
+}
+func (v Mountstat3) String() string {
+	if s, ok := _XdrNames_Mountstat3[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Mountstat3#%d", v)
+}
+func (v *Mountstat3) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Mountstat3[stok]; ok {
+			*v = Mountstat3(val)
+			return nil
+		} else if stok == "Mountstat3" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Mountstat3.", stok))
+	}
+}
+func (v Mountstat3) GetU32() uint32 { return uint32(v) }
+func (v *Mountstat3) SetU32(n uint32) { *v = Mountstat3(n) }
+func (v *Mountstat3) XdrPointer() interface{} { return v }
+func (Mountstat3) XdrTypeName() string { return "Mountstat3" }
+func (v Mountstat3) XdrValue() interface{} { return v }
+func (v *Mountstat3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Mountstat3 = *Mountstat3
+func XDR_Mountstat3(v *Mountstat3) *Mountstat3 { return v }
+type _XdrVec_unbounded_int32 []int32
+func (_XdrVec_unbounded_int32) XdrBound() uint32 {
+	const bound uint32 = 4294967295 // Force error if not const or doesn't fit
+	return bound
+}
+func (_XdrVec_unbounded_int32) XdrCheckLen(length uint32) {
+	if length > uint32(4294967295) {
+		XdrPanic("_XdrVec_unbounded_int32 length %d exceeds bound 4294967295", length)
+	} else if int(length) < 0 {
+		XdrPanic("_XdrVec_unbounded_int32 length %d exceeds max int", length)
+	}
+}
+func (v _XdrVec_unbounded_int32) GetVecLen() uint32 { return uint32(len(v)) }
+func (v *_XdrVec_unbounded_int32) SetVecLen(length uint32) {
+	v.XdrCheckLen(length)
+	if int(length) <= cap(*v) {
+		if int(length) != len(*v) {
+			*v = (*v)[:int(length)]
+		}
+		return
+	}
+	newcap := 2*cap(*v)
+	if newcap < int(length) { // also catches overflow where 2*cap < 0
+		newcap = int(length)
+	} else if bound := uint(4294967295); uint(newcap) > bound {
+		if int(bound) < 0 {
+			bound = ^uint(0) >> 1
+		}
+		newcap = int(bound)
+	}
+	nv := make([]int32, int(length), newcap)
+	copy(nv, *v)
+	*v = nv
+}
+func (v *_XdrVec_unbounded_int32) XdrMarshalN(x XDR, name string, n uint32) {
+	v.XdrCheckLen(n)
+	for i := 0; i < int(n); i++ {
+		if (i >= len(*v)) {
+			v.SetVecLen(uint32(i+1))
+		}
+		XDR_int32(&(*v)[i]).XdrMarshal(x, x.Sprintf("%s[%d]", name, i))
+	}
+	if int(n) < len(*v) {
+		*v = (*v)[:int(n)]
+	}
+}
+func (v *_XdrVec_unbounded_int32) XdrRecurse(x XDR, name string) {
+	size := XdrSize{ Size: uint32(len(*v)), Bound: 4294967295 }
+	x.Marshal(name, &size)
+	v.XdrMarshalN(x, name, size.Size)
+}
+func (_XdrVec_unbounded_int32) XdrTypeName() string { return "int32<>" }
+func (v *_XdrVec_unbounded_int32) XdrPointer() interface{} { return (*[]int32)(v) }
+func (v _XdrVec_unbounded_int32) XdrValue() interface{} { return ([]int32)(v) }
+func (v *_XdrVec_unbounded_int32) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Mountres3_ok = *Mountres3_ok
+func (v *Mountres3_ok) XdrPointer() interface{} { return v }
+func (Mountres3_ok) XdrTypeName() string { return "Mountres3_ok" }
+func (v Mountres3_ok) XdrValue() interface{} { return v }
+func (v *Mountres3_ok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Mountres3_ok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfhandle", name), XDR_Fhandle3(&v.Fhandle))
+	x.Marshal(x.Sprintf("%sauth_flavors", name), (*_XdrVec_unbounded_int32)(&v.Auth_flavors))
+}
+func XDR_Mountres3_ok(v *Mountres3_ok) *Mountres3_ok { return v }
+func (_ Mountres3) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Mountres3) Mountinfo() *Mountres3_ok {
+	switch u.Fhs_status {
+	case MNT3_OK:
+		if v, ok := u._u.(*Mountres3_ok); ok {
+			return v
+		} else {
+			var zero Mountres3_ok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Mountres3.Mountinfo accessed when Fhs_status == %v", u.Fhs_status)
+		return nil
+	}
+}
+func (u Mountres3) XdrValid() bool {
+	return true
+}
+func (u *Mountres3) XdrUnionTag() XdrNum32 {
+	return XDR_Mountstat3(&u.Fhs_status)
+}
+func (u *Mountres3) XdrUnionTagName() string {
+	return "Fhs_status"
+}
+func (u *Mountres3) XdrUnionBody() XdrType {
+	switch u.Fhs_status {
+	case MNT3_OK:
+		return XDR_Mountres3_ok(u.Mountinfo())
+	default:
+		return nil
+	}
+}
+func (u *Mountres3) XdrUnionBodyName() string {
+	switch u.Fhs_status {
+	case MNT3_OK:
+		return "Mountinfo"
+	default:
+		return ""
+	}
+}
+type XdrType_Mountres3 = *Mountres3
+func (v *Mountres3) XdrPointer() interface{} { return v }
+func (Mountres3) XdrTypeName() string { return "Mountres3" }
+func (v Mountres3) XdrValue() interface{} { return v }
+func (v *Mountres3) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Mountres3) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Mountstat3(&u.Fhs_status).XdrMarshal(x, x.Sprintf("%sfhs_status", name))
+	switch u.Fhs_status {
+	case MNT3_OK:
+		x.Marshal(x.Sprintf("%smountinfo", name), XDR_Mountres3_ok(u.Mountinfo()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Mountres3(v *Mountres3) *Mountres3 { return v}
+type _XdrPtr_Mountbody struct {
+	p **Mountbody
+}
+type _ptrflag_Mountbody _XdrPtr_Mountbody
+func (v _ptrflag_Mountbody) String() string {
+	if *v.p == nil {
+		return "nil"
+	}
+	return "non-nil"
+}
+func (v _ptrflag_Mountbody) Scan(ss fmt.ScanState, r rune) error {
+	tok, err := ss.Token(true, func(c rune) bool {
+		return c == '-' || (c >= 'a' && c <= 'z')
+	})
+	if err != nil {
+		return err
+	}
+	switch string(tok) {
+	case "nil":
+		v.SetU32(0)
+	case "non-nil":
+		v.SetU32(1)
+	default:
+		return XdrError("Mountbody flag should be \"nil\" or \"non-nil\"")
+	}
+	return nil
+}
+func (v _ptrflag_Mountbody) GetU32() uint32 {
+	if *v.p == nil {
+		return 0
+	}
+	return 1
+}
+func (v _ptrflag_Mountbody) SetU32(nv uint32) {
+	switch nv {
+	case 0:
+		*v.p = nil
+	case 1:
+		if *v.p == nil {
+			*v.p = new(Mountbody)
+		}
+	default:
+		XdrPanic("*Mountbody present flag value %d should be 0 or 1", nv)
+	}
+}
+func (_ptrflag_Mountbody) XdrTypeName() string { return "Mountbody?" }
+func (v _ptrflag_Mountbody) XdrPointer() interface{} { return nil }
+func (v _ptrflag_Mountbody) XdrValue() interface{} { return v.GetU32() != 0 }
+func (v _ptrflag_Mountbody) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _ptrflag_Mountbody) XdrBound() uint32 { return 1 }
+func (v _XdrPtr_Mountbody) GetPresent() bool { return *v.p != nil }
+func (v _XdrPtr_Mountbody) SetPresent(present bool) {
+	if !present {
+		*v.p = nil
+	} else if *v.p == nil {
+		*v.p = new(Mountbody)
+	}
+}
+func (v _XdrPtr_Mountbody) XdrMarshalValue(x XDR, name string) {
+	if *v.p != nil {
+		XDR_Mountbody(*v.p).XdrMarshal(x, name)
+	}
+}
+func (v _XdrPtr_Mountbody) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _XdrPtr_Mountbody) XdrRecurse(x XDR, name string) {
+	x.Marshal(name, _ptrflag_Mountbody(v))
+	v.XdrMarshalValue(x, name)
+}
+func (_XdrPtr_Mountbody) XdrTypeName() string { return "Mountbody*" }
+func (v _XdrPtr_Mountbody) XdrPointer() interface{} { return v.p }
+func (v _XdrPtr_Mountbody) XdrValue() interface{} { return *v.p }
+type XdrType_Mountlist struct {
+	_XdrPtr_Mountbody
+}
+func XDR_Mountlist(v *Mountlist) XdrType_Mountlist {
+	return XdrType_Mountlist{_XdrPtr_Mountbody{v}}
+}
+func (XdrType_Mountlist) XdrTypeName() string { return "Mountlist" }
+func (v XdrType_Mountlist) XdrUnwrap() XdrType { return v._XdrPtr_Mountbody }
+type XdrType_Mountbody = *Mountbody
+func (v *Mountbody) XdrPointer() interface{} { return v }
+func (Mountbody) XdrTypeName() string { return "Mountbody" }
+func (v Mountbody) XdrValue() interface{} { return v }
+func (v *Mountbody) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Mountbody) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sml_hostname", name), XDR_Name(&v.Ml_hostname))
+	x.Marshal(x.Sprintf("%sml_directory", name), XDR_Dirpath(&v.Ml_directory))
+	x.Marshal(x.Sprintf("%sml_next", name), XDR_Mountlist(&v.Ml_next))
+}
+func XDR_Mountbody(v *Mountbody) *Mountbody { return v }
+type _XdrPtr_Groupnode struct {
+	p **Groupnode
+}
+type _ptrflag_Groupnode _XdrPtr_Groupnode
+func (v _ptrflag_Groupnode) String() string {
+	if *v.p == nil {
+		return "nil"
+	}
+	return "non-nil"
+}
+func (v _ptrflag_Groupnode) Scan(ss fmt.ScanState, r rune) error {
+	tok, err := ss.Token(true, func(c rune) bool {
+		return c == '-' || (c >= 'a' && c <= 'z')
+	})
+	if err != nil {
+		return err
+	}
+	switch string(tok) {
+	case "nil":
+		v.SetU32(0)
+	case "non-nil":
+		v.SetU32(1)
+	default:
+		return XdrError("Groupnode flag should be \"nil\" or \"non-nil\"")
+	}
+	return nil
+}
+func (v _ptrflag_Groupnode) GetU32() uint32 {
+	if *v.p == nil {
+		return 0
+	}
+	return 1
+}
+func (v _ptrflag_Groupnode) SetU32(nv uint32) {
+	switch nv {
+	case 0:
+		*v.p = nil
+	case 1:
+		if *v.p == nil {
+			*v.p = new(Groupnode)
+		}
+	default:
+		XdrPanic("*Groupnode present flag value %d should be 0 or 1", nv)
+	}
+}
+func (_ptrflag_Groupnode) XdrTypeName() string { return "Groupnode?" }
+func (v _ptrflag_Groupnode) XdrPointer() interface{} { return nil }
+func (v _ptrflag_Groupnode) XdrValue() interface{} { return v.GetU32() != 0 }
+func (v _ptrflag_Groupnode) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _ptrflag_Groupnode) XdrBound() uint32 { return 1 }
+func (v _XdrPtr_Groupnode) GetPresent() bool { return *v.p != nil }
+func (v _XdrPtr_Groupnode) SetPresent(present bool) {
+	if !present {
+		*v.p = nil
+	} else if *v.p == nil {
+		*v.p = new(Groupnode)
+	}
+}
+func (v _XdrPtr_Groupnode) XdrMarshalValue(x XDR, name string) {
+	if *v.p != nil {
+		XDR_Groupnode(*v.p).XdrMarshal(x, name)
+	}
+}
+func (v _XdrPtr_Groupnode) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _XdrPtr_Groupnode) XdrRecurse(x XDR, name string) {
+	x.Marshal(name, _ptrflag_Groupnode(v))
+	v.XdrMarshalValue(x, name)
+}
+func (_XdrPtr_Groupnode) XdrTypeName() string { return "Groupnode*" }
+func (v _XdrPtr_Groupnode) XdrPointer() interface{} { return v.p }
+func (v _XdrPtr_Groupnode) XdrValue() interface{} { return *v.p }
+type XdrType_Groups struct {
+	_XdrPtr_Groupnode
+}
+func XDR_Groups(v *Groups) XdrType_Groups {
+	return XdrType_Groups{_XdrPtr_Groupnode{v}}
+}
+func (XdrType_Groups) XdrTypeName() string { return "Groups" }
+func (v XdrType_Groups) XdrUnwrap() XdrType { return v._XdrPtr_Groupnode }
+type XdrType_Groupnode = *Groupnode
+func (v *Groupnode) XdrPointer() interface{} { return v }
+func (Groupnode) XdrTypeName() string { return "Groupnode" }
+func (v Groupnode) XdrValue() interface{} { return v }
+func (v *Groupnode) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Groupnode) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sgr_name", name), XDR_Name(&v.Gr_name))
+	x.Marshal(x.Sprintf("%sgr_next", name), XDR_Groups(&v.Gr_next))
+}
+func XDR_Groupnode(v *Groupnode) *Groupnode { return v }
+type _XdrPtr_Exportnode struct {
+	p **Exportnode
+}
+type _ptrflag_Exportnode _XdrPtr_Exportnode
+func (v _ptrflag_Exportnode) String() string {
+	if *v.p == nil {
+		return "nil"
+	}
+	return "non-nil"
+}
+func (v _ptrflag_Exportnode) Scan(ss fmt.ScanState, r rune) error {
+	tok, err := ss.Token(true, func(c rune) bool {
+		return c == '-' || (c >= 'a' && c <= 'z')
+	})
+	if err != nil {
+		return err
+	}
+	switch string(tok) {
+	case "nil":
+		v.SetU32(0)
+	case "non-nil":
+		v.SetU32(1)
+	default:
+		return XdrError("Exportnode flag should be \"nil\" or \"non-nil\"")
+	}
+	return nil
+}
+func (v _ptrflag_Exportnode) GetU32() uint32 {
+	if *v.p == nil {
+		return 0
+	}
+	return 1
+}
+func (v _ptrflag_Exportnode) SetU32(nv uint32) {
+	switch nv {
+	case 0:
+		*v.p = nil
+	case 1:
+		if *v.p == nil {
+			*v.p = new(Exportnode)
+		}
+	default:
+		XdrPanic("*Exportnode present flag value %d should be 0 or 1", nv)
+	}
+}
+func (_ptrflag_Exportnode) XdrTypeName() string { return "Exportnode?" }
+func (v _ptrflag_Exportnode) XdrPointer() interface{} { return nil }
+func (v _ptrflag_Exportnode) XdrValue() interface{} { return v.GetU32() != 0 }
+func (v _ptrflag_Exportnode) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _ptrflag_Exportnode) XdrBound() uint32 { return 1 }
+func (v _XdrPtr_Exportnode) GetPresent() bool { return *v.p != nil }
+func (v _XdrPtr_Exportnode) SetPresent(present bool) {
+	if !present {
+		*v.p = nil
+	} else if *v.p == nil {
+		*v.p = new(Exportnode)
+	}
+}
+func (v _XdrPtr_Exportnode) XdrMarshalValue(x XDR, name string) {
+	if *v.p != nil {
+		XDR_Exportnode(*v.p).XdrMarshal(x, name)
+	}
+}
+func (v _XdrPtr_Exportnode) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v _XdrPtr_Exportnode) XdrRecurse(x XDR, name string) {
+	x.Marshal(name, _ptrflag_Exportnode(v))
+	v.XdrMarshalValue(x, name)
+}
+func (_XdrPtr_Exportnode) XdrTypeName() string { return "Exportnode*" }
+func (v _XdrPtr_Exportnode) XdrPointer() interface{} { return v.p }
+func (v _XdrPtr_Exportnode) XdrValue() interface{} { return *v.p }
+type XdrType_Exports struct {
+	_XdrPtr_Exportnode
+}
+func XDR_Exports(v *Exports) XdrType_Exports {
+	return XdrType_Exports{_XdrPtr_Exportnode{v}}
+}
+func (XdrType_Exports) XdrTypeName() string { return "Exports" }
+func (v XdrType_Exports) XdrUnwrap() XdrType { return v._XdrPtr_Exportnode }
+type XdrType_Exportnode = *Exportnode
+func (v *Exportnode) XdrPointer() interface{} { return v }
+func (Exportnode) XdrTypeName() string { return "Exportnode" }
+func (v Exportnode) XdrValue() interface{} { return v }
+func (v *Exportnode) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Exportnode) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sex_dir", name), XDR_Dirpath(&v.Ex_dir))
+	x.Marshal(x.Sprintf("%sex_groups", name), XDR_Groups(&v.Ex_groups))
+	x.Marshal(x.Sprintf("%sex_next", name), XDR_Exports(&v.Ex_next))
+}
+func XDR_Exportnode(v *Exportnode) *Exportnode { return v }
+
+type XdrProc_MOUNTPROC3_NULL struct {
+	Arg *XdrVoid
+	Res *XdrVoid
+}
+func (XdrProc_MOUNTPROC3_NULL) Prog() uint32 { return 100005 }
+func (XdrProc_MOUNTPROC3_NULL) Vers() uint32 { return 3 }
+func (XdrProc_MOUNTPROC3_NULL) Proc() uint32 { return 0 }
+func (XdrProc_MOUNTPROC3_NULL) ProgName() string { return "MOUNT_PROGRAM" }
+func (XdrProc_MOUNTPROC3_NULL) VersName() string { return "MOUNT_V3" }
+func (XdrProc_MOUNTPROC3_NULL) ProcName() string { return "MOUNTPROC3_NULL" }
+func (p *XdrProc_MOUNTPROC3_NULL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Arg)
+}
+func (p *XdrProc_MOUNTPROC3_NULL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_MOUNTPROC3_NULL{} // XXX
+
+type xdrSrvProc_MOUNTPROC3_NULL struct {
+	XdrProc_MOUNTPROC3_NULL
+	Srv MOUNT_V3
+}
+func (p *xdrSrvProc_MOUNTPROC3_NULL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) MOUNT_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_MOUNTPROC3_NULL) Do() {
+	p.Srv.MOUNTPROC3_NULL()
+}
+var _ XdrSrvProc = &xdrSrvProc_MOUNTPROC3_NULL{} // XXX
+
+type XdrProc_MOUNTPROC3_MNT struct {
+	Arg *Dirpath
+	Res *Mountres3
+}
+func (XdrProc_MOUNTPROC3_MNT) Prog() uint32 { return 100005 }
+func (XdrProc_MOUNTPROC3_MNT) Vers() uint32 { return 3 }
+func (XdrProc_MOUNTPROC3_MNT) Proc() uint32 { return 1 }
+func (XdrProc_MOUNTPROC3_MNT) ProgName() string { return "MOUNT_PROGRAM" }
+func (XdrProc_MOUNTPROC3_MNT) VersName() string { return "MOUNT_V3" }
+func (XdrProc_MOUNTPROC3_MNT) ProcName() string { return "MOUNTPROC3_MNT" }
+func (p *XdrProc_MOUNTPROC3_MNT) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Dirpath)
+	}
+	return XDR_Dirpath(p.Arg)
+}
+func (p *XdrProc_MOUNTPROC3_MNT) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Mountres3)
+	}
+	return XDR_Mountres3(p.Res)
+}
+var _ XdrProc = &XdrProc_MOUNTPROC3_MNT{} // XXX
+
+type xdrSrvProc_MOUNTPROC3_MNT struct {
+	XdrProc_MOUNTPROC3_MNT
+	Srv MOUNT_V3
+}
+func (p *xdrSrvProc_MOUNTPROC3_MNT) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) MOUNT_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_MOUNTPROC3_MNT) Do() {
+	r := p.Srv.MOUNTPROC3_MNT(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_MOUNTPROC3_MNT{} // XXX
+
+type XdrProc_MOUNTPROC3_DUMP struct {
+	Arg *XdrVoid
+	Res *Mountlist
+}
+func (XdrProc_MOUNTPROC3_DUMP) Prog() uint32 { return 100005 }
+func (XdrProc_MOUNTPROC3_DUMP) Vers() uint32 { return 3 }
+func (XdrProc_MOUNTPROC3_DUMP) Proc() uint32 { return 2 }
+func (XdrProc_MOUNTPROC3_DUMP) ProgName() string { return "MOUNT_PROGRAM" }
+func (XdrProc_MOUNTPROC3_DUMP) VersName() string { return "MOUNT_V3" }
+func (XdrProc_MOUNTPROC3_DUMP) ProcName() string { return "MOUNTPROC3_DUMP" }
+func (p *XdrProc_MOUNTPROC3_DUMP) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Arg)
+}
+func (p *XdrProc_MOUNTPROC3_DUMP) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Mountlist)
+	}
+	return XDR_Mountlist(p.Res)
+}
+var _ XdrProc = &XdrProc_MOUNTPROC3_DUMP{} // XXX
+
+type xdrSrvProc_MOUNTPROC3_DUMP struct {
+	XdrProc_MOUNTPROC3_DUMP
+	Srv MOUNT_V3
+}
+func (p *xdrSrvProc_MOUNTPROC3_DUMP) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) MOUNT_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_MOUNTPROC3_DUMP) Do() {
+	r := p.Srv.MOUNTPROC3_DUMP()
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_MOUNTPROC3_DUMP{} // XXX
+
+type XdrProc_MOUNTPROC3_UMNT struct {
+	Arg *Dirpath
+	Res *XdrVoid
+}
+func (XdrProc_MOUNTPROC3_UMNT) Prog() uint32 { return 100005 }
+func (XdrProc_MOUNTPROC3_UMNT) Vers() uint32 { return 3 }
+func (XdrProc_MOUNTPROC3_UMNT) Proc() uint32 { return 3 }
+func (XdrProc_MOUNTPROC3_UMNT) ProgName() string { return "MOUNT_PROGRAM" }
+func (XdrProc_MOUNTPROC3_UMNT) VersName() string { return "MOUNT_V3" }
+func (XdrProc_MOUNTPROC3_UMNT) ProcName() string { return "MOUNTPROC3_UMNT" }
+func (p *XdrProc_MOUNTPROC3_UMNT) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Dirpath)
+	}
+	return XDR_Dirpath(p.Arg)
+}
+func (p *XdrProc_MOUNTPROC3_UMNT) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_MOUNTPROC3_UMNT{} // XXX
+
+type xdrSrvProc_MOUNTPROC3_UMNT struct {
+	XdrProc_MOUNTPROC3_UMNT
+	Srv MOUNT_V3
+}
+func (p *xdrSrvProc_MOUNTPROC3_UMNT) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) MOUNT_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_MOUNTPROC3_UMNT) Do() {
+	p.Srv.MOUNTPROC3_UMNT(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_MOUNTPROC3_UMNT{} // XXX
+
+type XdrProc_MOUNTPROC3_UMNTALL struct {
+	Arg *XdrVoid
+	Res *XdrVoid
+}
+func (XdrProc_MOUNTPROC3_UMNTALL) Prog() uint32 { return 100005 }
+func (XdrProc_MOUNTPROC3_UMNTALL) Vers() uint32 { return 3 }
+func (XdrProc_MOUNTPROC3_UMNTALL) Proc() uint32 { return 4 }
+func (XdrProc_MOUNTPROC3_UMNTALL) ProgName() string { return "MOUNT_PROGRAM" }
+func (XdrProc_MOUNTPROC3_UMNTALL) VersName() string { return "MOUNT_V3" }
+func (XdrProc_MOUNTPROC3_UMNTALL) ProcName() string { return "MOUNTPROC3_UMNTALL" }
+func (p *XdrProc_MOUNTPROC3_UMNTALL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Arg)
+}
+func (p *XdrProc_MOUNTPROC3_UMNTALL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_MOUNTPROC3_UMNTALL{} // XXX
+
+type xdrSrvProc_MOUNTPROC3_UMNTALL struct {
+	XdrProc_MOUNTPROC3_UMNTALL
+	Srv MOUNT_V3
+}
+func (p *xdrSrvProc_MOUNTPROC3_UMNTALL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) MOUNT_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_MOUNTPROC3_UMNTALL) Do() {
+	p.Srv.MOUNTPROC3_UMNTALL()
+}
+var _ XdrSrvProc = &xdrSrvProc_MOUNTPROC3_UMNTALL{} // XXX
+
+type XdrProc_MOUNTPROC3_EXPORT struct {
+	Arg *XdrVoid
+	Res *Exports
+}
+func (XdrProc_MOUNTPROC3_EXPORT) Prog() uint32 { return 100005 }
+func (XdrProc_MOUNTPROC3_EXPORT) Vers() uint32 { return 3 }
+func (XdrProc_MOUNTPROC3_EXPORT) Proc() uint32 { return 5 }
+func (XdrProc_MOUNTPROC3_EXPORT) ProgName() string { return "MOUNT_PROGRAM" }
+func (XdrProc_MOUNTPROC3_EXPORT) VersName() string { return "MOUNT_V3" }
+func (XdrProc_MOUNTPROC3_EXPORT) ProcName() string { return "MOUNTPROC3_EXPORT" }
+func (p *XdrProc_MOUNTPROC3_EXPORT) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Arg)
+}
+func (p *XdrProc_MOUNTPROC3_EXPORT) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Exports)
+	}
+	return XDR_Exports(p.Res)
+}
+var _ XdrProc = &XdrProc_MOUNTPROC3_EXPORT{} // XXX
+
+type xdrSrvProc_MOUNTPROC3_EXPORT struct {
+	XdrProc_MOUNTPROC3_EXPORT
+	Srv MOUNT_V3
+}
+func (p *xdrSrvProc_MOUNTPROC3_EXPORT) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) MOUNT_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_MOUNTPROC3_EXPORT) Do() {
+	r := p.Srv.MOUNTPROC3_EXPORT()
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_MOUNTPROC3_EXPORT{} // XXX
+
+func init() {
+	XdrCatalog[100005<<32|3] = func(p uint32) XdrProc {
+		switch(p) {
+		case 0:
+			return &XdrProc_MOUNTPROC3_NULL{}
+		case 1:
+			return &XdrProc_MOUNTPROC3_MNT{}
+		case 2:
+			return &XdrProc_MOUNTPROC3_DUMP{}
+		case 3:
+			return &XdrProc_MOUNTPROC3_UMNT{}
+		case 4:
+			return &XdrProc_MOUNTPROC3_UMNTALL{}
+		case 5:
+			return &XdrProc_MOUNTPROC3_EXPORT{}
+		}
+		return nil
+	}
+}
+
+type MOUNT_V3_Server struct {
+	Srv MOUNT_V3
+}
+func (MOUNT_V3_Server) Prog() uint32 { return 100005 }
+func (MOUNT_V3_Server) Vers() uint32 { return 3 }
+func (MOUNT_V3_Server) ProgName() string { return "MOUNT_PROGRAM" }
+func (MOUNT_V3_Server) VersName() string { return "MOUNT_V3" }
+func (s MOUNT_V3_Server) GetProc(p uint32) XdrSrvProc {
+	switch p {
+	case 0:  // MOUNTPROC3_NULL
+		return &xdrSrvProc_MOUNTPROC3_NULL{ Srv: s.Srv }
+	case 1:  // MOUNTPROC3_MNT
+		return &xdrSrvProc_MOUNTPROC3_MNT{ Srv: s.Srv }
+	case 2:  // MOUNTPROC3_DUMP
+		return &xdrSrvProc_MOUNTPROC3_DUMP{ Srv: s.Srv }
+	case 3:  // MOUNTPROC3_UMNT
+		return &xdrSrvProc_MOUNTPROC3_UMNT{ Srv: s.Srv }
+	case 4:  // MOUNTPROC3_UMNTALL
+		return &xdrSrvProc_MOUNTPROC3_UMNTALL{ Srv: s.Srv }
+	case 5:  // MOUNTPROC3_EXPORT
+		return &xdrSrvProc_MOUNTPROC3_EXPORT{ Srv: s.Srv }
+	default:
+		return nil
+	}
+}
+var _ XdrSrv = MOUNT_V3_Server{} // XXX
+
+type MOUNT_V3_Client struct {
+	Send XdrSendCall
+	Ctx context.Context
+}
+var _ MOUNT_V3 = MOUNT_V3_Client{} // XXX
+func (c MOUNT_V3_Client) WithContext(ctx context.Context) MOUNT_V3 {
+	c.Ctx = ctx
+	return c
+}
+func (c MOUNT_V3_Client) MOUNTPROC3_NULL() {
+	var proc XdrProc_MOUNTPROC3_NULL
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c MOUNT_V3_Client) MOUNTPROC3_MNT(a1 Dirpath) Mountres3 {
+	var proc XdrProc_MOUNTPROC3_MNT
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c MOUNT_V3_Client) MOUNTPROC3_DUMP() Mountlist {
+	var proc XdrProc_MOUNTPROC3_DUMP
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c MOUNT_V3_Client) MOUNTPROC3_UMNT(a1 Dirpath) {
+	var proc XdrProc_MOUNTPROC3_UMNT
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c MOUNT_V3_Client) MOUNTPROC3_UMNTALL() {
+	var proc XdrProc_MOUNTPROC3_UMNTALL
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c MOUNT_V3_Client) MOUNTPROC3_EXPORT() Exports {
+	var proc XdrProc_MOUNTPROC3_EXPORT
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+type XdrType_Netobj struct {
+	XdrVecOpaque
+}
+func XDR_Netobj(v *Netobj) XdrType_Netobj {
+	return XdrType_Netobj{XdrVecOpaque{v, MAXNETOBJ_SZ}}
+}
+func (XdrType_Netobj) XdrTypeName() string { return "Netobj" }
+func (v XdrType_Netobj) XdrUnwrap() XdrType { return v.XdrVecOpaque }
+var _XdrNames_Nlm4_stats = map[int32]string{
+	int32(NLM4_GRANTED): "NLM4_GRANTED",
+	int32(NLM4_DENIED): "NLM4_DENIED",
+	int32(NLM4_DENIED_NOLOCKS): "NLM4_DENIED_NOLOCKS",
+	int32(NLM4_BLOCKED): "NLM4_BLOCKED",
+	int32(NLM4_DENIED_GRACE_PERIOD): "NLM4_DENIED_GRACE_PERIOD",
+	int32(NLM4_DEADLCK): "NLM4_DEADLCK",
+	int32(NLM4_ROFS): "NLM4_ROFS",
+	int32(NLM4_STALE_FH): "NLM4_STALE_FH",
+	int32(NLM4_FBIG): "NLM4_FBIG",
+	int32(NLM4_FAILED): "NLM4_FAILED",
+}
+var _XdrValues_Nlm4_stats = map[string]int32{
+	"NLM4_GRANTED": int32(NLM4_GRANTED),
+	"NLM4_DENIED": int32(NLM4_DENIED),
+	"NLM4_DENIED_NOLOCKS": int32(NLM4_DENIED_NOLOCKS),
+	"NLM4_BLOCKED": int32(NLM4_BLOCKED),
+	"NLM4_DENIED_GRACE_PERIOD": int32(NLM4_DENIED_GRACE_PERIOD),
+	"NLM4_DEADLCK": int32(NLM4_DEADLCK),
+	"NLM4_ROFS": int32(NLM4_ROFS),
+	"NLM4_STALE_FH": int32(NLM4_STALE_FH),
+	"NLM4_FBIG": int32(NLM4_FBIG),
+	"NLM4_FAILED": int32(NLM4_FAILED),
+}
+func (Nlm4_stats) XdrEnumNames() map[int32]string {
+	return _XdrNames_Nlm4_stats
+}
+func (v Nlm4_stats) String() string {
+	if s, ok := _XdrNames_Nlm4_stats[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Nlm4_stats#%d", v)
+}
+func (v *Nlm4_stats) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Nlm4_stats[stok]; ok {
+			*v = Nlm4_stats(val)
+			return nil
+		} else if stok == "Nlm4_stats" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Nlm4_stats.", stok))
+	}
+}
+func (v Nlm4_stats) GetU32() uint32 { return uint32(v) }
+func (v *Nlm4_stats) SetU32(n uint32) { *v = Nlm4_stats(n) }
+func (v *Nlm4_stats) XdrPointer() interface{} { return v }
+func (Nlm4_stats) XdrTypeName() string { return "Nlm4_stats" }
+func (v Nlm4_stats) XdrValue() interface{} { return v }
+func (v *Nlm4_stats) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Nlm4_stats = *Nlm4_stats
+func XDR_Nlm4_stats(v *Nlm4_stats) *Nlm4_stats { return v }
+type XdrType_Nlm4_holder = *Nlm4_holder
+func (v *Nlm4_holder) XdrPointer() interface{} { return v }
+func (Nlm4_holder) XdrTypeName() string { return "Nlm4_holder" }
+func (v Nlm4_holder) XdrValue() interface{} { return v }
+func (v *Nlm4_holder) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_holder) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sexclusive", name), XDR_bool(&v.Exclusive))
+	x.Marshal(x.Sprintf("%ssvid", name), XDR_int32(&v.Svid))
+	x.Marshal(x.Sprintf("%soh", name), XDR_Netobj(&v.Oh))
+	x.Marshal(x.Sprintf("%sl_offset", name), XDR_uint64(&v.L_offset))
+	x.Marshal(x.Sprintf("%sl_len", name), XDR_uint64(&v.L_len))
+}
+func XDR_Nlm4_holder(v *Nlm4_holder) *Nlm4_holder { return v }
+func (_ Nlm4_testrply) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *Nlm4_testrply) Holder() *Nlm4_holder {
+	switch u.Stat {
+	case NLM4_DENIED:
+		if v, ok := u._u.(*Nlm4_holder); ok {
+			return v
+		} else {
+			var zero Nlm4_holder
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("Nlm4_testrply.Holder accessed when Stat == %v", u.Stat)
+		return nil
+	}
+}
+func (u Nlm4_testrply) XdrValid() bool {
+	return true
+}
+func (u *Nlm4_testrply) XdrUnionTag() XdrNum32 {
+	return XDR_Nlm4_stats(&u.Stat)
+}
+func (u *Nlm4_testrply) XdrUnionTagName() string {
+	return "Stat"
+}
+func (u *Nlm4_testrply) XdrUnionBody() XdrType {
+	switch u.Stat {
+	case NLM4_DENIED:
+		return XDR_Nlm4_holder(u.Holder())
+	default:
+		return nil
+	}
+}
+func (u *Nlm4_testrply) XdrUnionBodyName() string {
+	switch u.Stat {
+	case NLM4_DENIED:
+		return "Holder"
+	default:
+		return ""
+	}
+}
+type XdrType_Nlm4_testrply = *Nlm4_testrply
+func (v *Nlm4_testrply) XdrPointer() interface{} { return v }
+func (Nlm4_testrply) XdrTypeName() string { return "Nlm4_testrply" }
+func (v Nlm4_testrply) XdrValue() interface{} { return v }
+func (v *Nlm4_testrply) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *Nlm4_testrply) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nlm4_stats(&u.Stat).XdrMarshal(x, x.Sprintf("%sstat", name))
+	switch u.Stat {
+	case NLM4_DENIED:
+		x.Marshal(x.Sprintf("%sholder", name), XDR_Nlm4_holder(u.Holder()))
+		return
+	default:
+		return
+	}
+}
+func XDR_Nlm4_testrply(v *Nlm4_testrply) *Nlm4_testrply { return v}
+type XdrType_Nlm4_stat = *Nlm4_stat
+func (v *Nlm4_stat) XdrPointer() interface{} { return v }
+func (Nlm4_stat) XdrTypeName() string { return "Nlm4_stat" }
+func (v Nlm4_stat) XdrValue() interface{} { return v }
+func (v *Nlm4_stat) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_stat) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sstat", name), XDR_Nlm4_stats(&v.Stat))
+}
+func XDR_Nlm4_stat(v *Nlm4_stat) *Nlm4_stat { return v }
+type XdrType_Nlm4_res = *Nlm4_res
+func (v *Nlm4_res) XdrPointer() interface{} { return v }
+func (Nlm4_res) XdrTypeName() string { return "Nlm4_res" }
+func (v Nlm4_res) XdrValue() interface{} { return v }
+func (v *Nlm4_res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Netobj(&v.Cookie))
+	x.Marshal(x.Sprintf("%sstat", name), XDR_Nlm4_stat(&v.Stat))
+}
+func XDR_Nlm4_res(v *Nlm4_res) *Nlm4_res { return v }
+type XdrType_Nlm4_testres = *Nlm4_testres
+func (v *Nlm4_testres) XdrPointer() interface{} { return v }
+func (Nlm4_testres) XdrTypeName() string { return "Nlm4_testres" }
+func (v Nlm4_testres) XdrValue() interface{} { return v }
+func (v *Nlm4_testres) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_testres) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Netobj(&v.Cookie))
+	x.Marshal(x.Sprintf("%stest_stat", name), XDR_Nlm4_testrply(&v.Test_stat))
+}
+func XDR_Nlm4_testres(v *Nlm4_testres) *Nlm4_testres { return v }
+type XdrType_Nlm4_lock = *Nlm4_lock
+func (v *Nlm4_lock) XdrPointer() interface{} { return v }
+func (Nlm4_lock) XdrTypeName() string { return "Nlm4_lock" }
+func (v Nlm4_lock) XdrValue() interface{} { return v }
+func (v *Nlm4_lock) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_lock) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scaller_name", name), XdrString{&v.Caller_name, LM_MAXSTRLEN})
+	x.Marshal(x.Sprintf("%sfh", name), XDR_Netobj(&v.Fh))
+	x.Marshal(x.Sprintf("%soh", name), XDR_Netobj(&v.Oh))
+	x.Marshal(x.Sprintf("%ssvid", name), XDR_int32(&v.Svid))
+	x.Marshal(x.Sprintf("%sl_offset", name), XDR_uint64(&v.L_offset))
+	x.Marshal(x.Sprintf("%sl_len", name), XDR_uint64(&v.L_len))
+}
+func XDR_Nlm4_lock(v *Nlm4_lock) *Nlm4_lock { return v }
+type XdrType_Nlm4_lockargs = *Nlm4_lockargs
+func (v *Nlm4_lockargs) XdrPointer() interface{} { return v }
+func (Nlm4_lockargs) XdrTypeName() string { return "Nlm4_lockargs" }
+func (v Nlm4_lockargs) XdrValue() interface{} { return v }
+func (v *Nlm4_lockargs) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_lockargs) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Netobj(&v.Cookie))
+	x.Marshal(x.Sprintf("%sblock", name), XDR_bool(&v.Block))
+	x.Marshal(x.Sprintf("%sexclusive", name), XDR_bool(&v.Exclusive))
+	x.Marshal(x.Sprintf("%salock", name), XDR_Nlm4_lock(&v.Alock))
+	x.Marshal(x.Sprintf("%sreclaim", name), XDR_bool(&v.Reclaim))
+	x.Marshal(x.Sprintf("%sstate", name), XDR_int32(&v.State))
+}
+func XDR_Nlm4_lockargs(v *Nlm4_lockargs) *Nlm4_lockargs { return v }
+type XdrType_Nlm4_cancargs = *Nlm4_cancargs
+func (v *Nlm4_cancargs) XdrPointer() interface{} { return v }
+func (Nlm4_cancargs) XdrTypeName() string { return "Nlm4_cancargs" }
+func (v Nlm4_cancargs) XdrValue() interface{} { return v }
+func (v *Nlm4_cancargs) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_cancargs) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Netobj(&v.Cookie))
+	x.Marshal(x.Sprintf("%sblock", name), XDR_bool(&v.Block))
+	x.Marshal(x.Sprintf("%sexclusive", name), XDR_bool(&v.Exclusive))
+	x.Marshal(x.Sprintf("%salock", name), XDR_Nlm4_lock(&v.Alock))
+}
+func XDR_Nlm4_cancargs(v *Nlm4_cancargs) *Nlm4_cancargs { return v }
+type XdrType_Nlm4_testargs = *Nlm4_testargs
+func (v *Nlm4_testargs) XdrPointer() interface{} { return v }
+func (Nlm4_testargs) XdrTypeName() string { return "Nlm4_testargs" }
+func (v Nlm4_testargs) XdrValue() interface{} { return v }
+func (v *Nlm4_testargs) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_testargs) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Netobj(&v.Cookie))
+	x.Marshal(x.Sprintf("%sexclusive", name), XDR_bool(&v.Exclusive))
+	x.Marshal(x.Sprintf("%salock", name), XDR_Nlm4_lock(&v.Alock))
+}
+func XDR_Nlm4_testargs(v *Nlm4_testargs) *Nlm4_testargs { return v }
+type XdrType_Nlm4_unlockargs = *Nlm4_unlockargs
+func (v *Nlm4_unlockargs) XdrPointer() interface{} { return v }
+func (Nlm4_unlockargs) XdrTypeName() string { return "Nlm4_unlockargs" }
+func (v Nlm4_unlockargs) XdrValue() interface{} { return v }
+func (v *Nlm4_unlockargs) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_unlockargs) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Netobj(&v.Cookie))
+	x.Marshal(x.Sprintf("%salock", name), XDR_Nlm4_lock(&v.Alock))
+}
+func XDR_Nlm4_unlockargs(v *Nlm4_unlockargs) *Nlm4_unlockargs { return v }
+var _XdrNames_Fsh4_mode = map[int32]string{
+	int32(Fsm_DN): "fsm_DN",
+	int32(Fsm_DR): "fsm_DR",
+	int32(Fsm_DW): "fsm_DW",
+	int32(Fsm_DRW): "fsm_DRW",
+}
+var _XdrValues_Fsh4_mode = map[string]int32{
+	"fsm_DN": int32(Fsm_DN),
+	"fsm_DR": int32(Fsm_DR),
+	"fsm_DW": int32(Fsm_DW),
+	"fsm_DRW": int32(Fsm_DRW),
+}
+func (Fsh4_mode) XdrEnumNames() map[int32]string {
+	return _XdrNames_Fsh4_mode
+}
+func (v Fsh4_mode) String() string {
+	if s, ok := _XdrNames_Fsh4_mode[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Fsh4_mode#%d", v)
+}
+func (v *Fsh4_mode) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Fsh4_mode[stok]; ok {
+			*v = Fsh4_mode(val)
+			return nil
+		} else if stok == "Fsh4_mode" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Fsh4_mode.", stok))
+	}
+}
+func (v Fsh4_mode) GetU32() uint32 { return uint32(v) }
+func (v *Fsh4_mode) SetU32(n uint32) { *v = Fsh4_mode(n) }
+func (v *Fsh4_mode) XdrPointer() interface{} { return v }
+func (Fsh4_mode) XdrTypeName() string { return "Fsh4_mode" }
+func (v Fsh4_mode) XdrValue() interface{} { return v }
+func (v *Fsh4_mode) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Fsh4_mode = *Fsh4_mode
+func XDR_Fsh4_mode(v *Fsh4_mode) *Fsh4_mode { return v }
+var _XdrNames_Fsh4_access = map[int32]string{
+	int32(Fsa_NONE): "fsa_NONE",
+	int32(Fsa_R): "fsa_R",
+	int32(Fsa_W): "fsa_W",
+	int32(Fsa_RW): "fsa_RW",
+}
+var _XdrValues_Fsh4_access = map[string]int32{
+	"fsa_NONE": int32(Fsa_NONE),
+	"fsa_R": int32(Fsa_R),
+	"fsa_W": int32(Fsa_W),
+	"fsa_RW": int32(Fsa_RW),
+}
+func (Fsh4_access) XdrEnumNames() map[int32]string {
+	return _XdrNames_Fsh4_access
+}
+func (v Fsh4_access) String() string {
+	if s, ok := _XdrNames_Fsh4_access[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Fsh4_access#%d", v)
+}
+func (v *Fsh4_access) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Fsh4_access[stok]; ok {
+			*v = Fsh4_access(val)
+			return nil
+		} else if stok == "Fsh4_access" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Fsh4_access.", stok))
+	}
+}
+func (v Fsh4_access) GetU32() uint32 { return uint32(v) }
+func (v *Fsh4_access) SetU32(n uint32) { *v = Fsh4_access(n) }
+func (v *Fsh4_access) XdrPointer() interface{} { return v }
+func (Fsh4_access) XdrTypeName() string { return "Fsh4_access" }
+func (v Fsh4_access) XdrValue() interface{} { return v }
+func (v *Fsh4_access) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Fsh4_access = *Fsh4_access
+func XDR_Fsh4_access(v *Fsh4_access) *Fsh4_access { return v }
+type XdrType_Nlm4_share = *Nlm4_share
+func (v *Nlm4_share) XdrPointer() interface{} { return v }
+func (Nlm4_share) XdrTypeName() string { return "Nlm4_share" }
+func (v Nlm4_share) XdrValue() interface{} { return v }
+func (v *Nlm4_share) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_share) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scaller_name", name), XdrString{&v.Caller_name, LM_MAXSTRLEN})
+	x.Marshal(x.Sprintf("%sfh", name), XDR_Netobj(&v.Fh))
+	x.Marshal(x.Sprintf("%soh", name), XDR_Netobj(&v.Oh))
+	x.Marshal(x.Sprintf("%smode", name), XDR_Fsh4_mode(&v.Mode))
+	x.Marshal(x.Sprintf("%saccess", name), XDR_Fsh4_access(&v.Access))
+}
+func XDR_Nlm4_share(v *Nlm4_share) *Nlm4_share { return v }
+type XdrType_Nlm4_shareargs = *Nlm4_shareargs
+func (v *Nlm4_shareargs) XdrPointer() interface{} { return v }
+func (Nlm4_shareargs) XdrTypeName() string { return "Nlm4_shareargs" }
+func (v Nlm4_shareargs) XdrValue() interface{} { return v }
+func (v *Nlm4_shareargs) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_shareargs) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Netobj(&v.Cookie))
+	x.Marshal(x.Sprintf("%sshare", name), XDR_Nlm4_share(&v.Share))
+	x.Marshal(x.Sprintf("%sreclaim", name), XDR_bool(&v.Reclaim))
+}
+func XDR_Nlm4_shareargs(v *Nlm4_shareargs) *Nlm4_shareargs { return v }
+type XdrType_Nlm4_shareres = *Nlm4_shareres
+func (v *Nlm4_shareres) XdrPointer() interface{} { return v }
+func (Nlm4_shareres) XdrTypeName() string { return "Nlm4_shareres" }
+func (v Nlm4_shareres) XdrValue() interface{} { return v }
+func (v *Nlm4_shareres) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_shareres) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%scookie", name), XDR_Netobj(&v.Cookie))
+	x.Marshal(x.Sprintf("%sstat", name), XDR_Nlm4_stats(&v.Stat))
+	x.Marshal(x.Sprintf("%ssequence", name), XDR_int32(&v.Sequence))
+}
+func XDR_Nlm4_shareres(v *Nlm4_shareres) *Nlm4_shareres { return v }
+type XdrType_Nlm4_notify = *Nlm4_notify
+func (v *Nlm4_notify) XdrPointer() interface{} { return v }
+func (Nlm4_notify) XdrTypeName() string { return "Nlm4_notify" }
+func (v Nlm4_notify) XdrValue() interface{} { return v }
+func (v *Nlm4_notify) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_notify) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sname", name), XdrString{&v.Name, LM_MAXSTRLEN})
+	x.Marshal(x.Sprintf("%sstate", name), XDR_int32(&v.State))
+}
+func XDR_Nlm4_notify(v *Nlm4_notify) *Nlm4_notify { return v }
+type XdrType_Nlm4_sm_status = *Nlm4_sm_status
+func (v *Nlm4_sm_status) XdrPointer() interface{} { return v }
+func (Nlm4_sm_status) XdrTypeName() string { return "Nlm4_sm_status" }
+func (v Nlm4_sm_status) XdrValue() interface{} { return v }
+func (v *Nlm4_sm_status) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Nlm4_sm_status) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%smon_name", name), XdrString{&v.Mon_name, LM_MAXSTRLEN})
+	x.Marshal(x.Sprintf("%sstate", name), XDR_int32(&v.State))
+	x.Marshal(x.Sprintf("%spriv", name), (*_XdrArray_16_opaque)(&v.Priv))
+}
+func XDR_Nlm4_sm_status(v *Nlm4_sm_status) *Nlm4_sm_status { return v }
+
+type XdrProc_NLMPROC4_NULL struct {
+	Arg *XdrVoid
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_NULL) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_NULL) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_NULL) Proc() uint32 { return 0 }
+func (XdrProc_NLMPROC4_NULL) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_NULL) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_NULL) ProcName() string { return "NLMPROC4_NULL" }
+func (p *XdrProc_NLMPROC4_NULL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_NULL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_NULL{} // XXX
+
+type xdrSrvProc_NLMPROC4_NULL struct {
+	XdrProc_NLMPROC4_NULL
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_NULL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_NULL) Do() {
+	p.Srv.NLMPROC4_NULL()
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_NULL{} // XXX
+
+type XdrProc_NLMPROC4_TEST struct {
+	Arg *Nlm4_testargs
+	Res *Nlm4_testres
+}
+func (XdrProc_NLMPROC4_TEST) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_TEST) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_TEST) Proc() uint32 { return 1 }
+func (XdrProc_NLMPROC4_TEST) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_TEST) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_TEST) ProcName() string { return "NLMPROC4_TEST" }
+func (p *XdrProc_NLMPROC4_TEST) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_testargs)
+	}
+	return XDR_Nlm4_testargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_TEST) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Nlm4_testres)
+	}
+	return XDR_Nlm4_testres(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_TEST{} // XXX
+
+type xdrSrvProc_NLMPROC4_TEST struct {
+	XdrProc_NLMPROC4_TEST
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_TEST) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_TEST) Do() {
+	r := p.Srv.NLMPROC4_TEST(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_TEST{} // XXX
+
+type XdrProc_NLMPROC4_LOCK struct {
+	Arg *Nlm4_lockargs
+	Res *Nlm4_res
+}
+func (XdrProc_NLMPROC4_LOCK) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_LOCK) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_LOCK) Proc() uint32 { return 2 }
+func (XdrProc_NLMPROC4_LOCK) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_LOCK) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_LOCK) ProcName() string { return "NLMPROC4_LOCK" }
+func (p *XdrProc_NLMPROC4_LOCK) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_lockargs)
+	}
+	return XDR_Nlm4_lockargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_LOCK) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_LOCK{} // XXX
+
+type xdrSrvProc_NLMPROC4_LOCK struct {
+	XdrProc_NLMPROC4_LOCK
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_LOCK) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_LOCK) Do() {
+	r := p.Srv.NLMPROC4_LOCK(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_LOCK{} // XXX
+
+type XdrProc_NLMPROC4_CANCEL struct {
+	Arg *Nlm4_cancargs
+	Res *Nlm4_res
+}
+func (XdrProc_NLMPROC4_CANCEL) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_CANCEL) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_CANCEL) Proc() uint32 { return 3 }
+func (XdrProc_NLMPROC4_CANCEL) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_CANCEL) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_CANCEL) ProcName() string { return "NLMPROC4_CANCEL" }
+func (p *XdrProc_NLMPROC4_CANCEL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_cancargs)
+	}
+	return XDR_Nlm4_cancargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_CANCEL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_CANCEL{} // XXX
+
+type xdrSrvProc_NLMPROC4_CANCEL struct {
+	XdrProc_NLMPROC4_CANCEL
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_CANCEL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_CANCEL) Do() {
+	r := p.Srv.NLMPROC4_CANCEL(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_CANCEL{} // XXX
+
+type XdrProc_NLMPROC4_UNLOCK struct {
+	Arg *Nlm4_unlockargs
+	Res *Nlm4_res
+}
+func (XdrProc_NLMPROC4_UNLOCK) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_UNLOCK) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_UNLOCK) Proc() uint32 { return 4 }
+func (XdrProc_NLMPROC4_UNLOCK) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_UNLOCK) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_UNLOCK) ProcName() string { return "NLMPROC4_UNLOCK" }
+func (p *XdrProc_NLMPROC4_UNLOCK) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_unlockargs)
+	}
+	return XDR_Nlm4_unlockargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_UNLOCK) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_UNLOCK{} // XXX
+
+type xdrSrvProc_NLMPROC4_UNLOCK struct {
+	XdrProc_NLMPROC4_UNLOCK
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_UNLOCK) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_UNLOCK) Do() {
+	r := p.Srv.NLMPROC4_UNLOCK(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_UNLOCK{} // XXX
+
+type XdrProc_NLMPROC4_GRANTED struct {
+	Arg *Nlm4_testargs
+	Res *Nlm4_res
+}
+func (XdrProc_NLMPROC4_GRANTED) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_GRANTED) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_GRANTED) Proc() uint32 { return 5 }
+func (XdrProc_NLMPROC4_GRANTED) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_GRANTED) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_GRANTED) ProcName() string { return "NLMPROC4_GRANTED" }
+func (p *XdrProc_NLMPROC4_GRANTED) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_testargs)
+	}
+	return XDR_Nlm4_testargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_GRANTED) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_GRANTED{} // XXX
+
+type xdrSrvProc_NLMPROC4_GRANTED struct {
+	XdrProc_NLMPROC4_GRANTED
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_GRANTED) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_GRANTED) Do() {
+	r := p.Srv.NLMPROC4_GRANTED(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_GRANTED{} // XXX
+
+type XdrProc_NLMPROC4_TEST_MSG struct {
+	Arg *Nlm4_testargs
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_TEST_MSG) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_TEST_MSG) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_TEST_MSG) Proc() uint32 { return 6 }
+func (XdrProc_NLMPROC4_TEST_MSG) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_TEST_MSG) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_TEST_MSG) ProcName() string { return "NLMPROC4_TEST_MSG" }
+func (p *XdrProc_NLMPROC4_TEST_MSG) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_testargs)
+	}
+	return XDR_Nlm4_testargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_TEST_MSG) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_TEST_MSG{} // XXX
+
+type xdrSrvProc_NLMPROC4_TEST_MSG struct {
+	XdrProc_NLMPROC4_TEST_MSG
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_TEST_MSG) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_TEST_MSG) Do() {
+	p.Srv.NLMPROC4_TEST_MSG(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_TEST_MSG{} // XXX
+
+type XdrProc_NLMPROC4_LOCK_MSG struct {
+	Arg *Nlm4_lockargs
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_LOCK_MSG) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_LOCK_MSG) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_LOCK_MSG) Proc() uint32 { return 7 }
+func (XdrProc_NLMPROC4_LOCK_MSG) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_LOCK_MSG) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_LOCK_MSG) ProcName() string { return "NLMPROC4_LOCK_MSG" }
+func (p *XdrProc_NLMPROC4_LOCK_MSG) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_lockargs)
+	}
+	return XDR_Nlm4_lockargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_LOCK_MSG) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_LOCK_MSG{} // XXX
+
+type xdrSrvProc_NLMPROC4_LOCK_MSG struct {
+	XdrProc_NLMPROC4_LOCK_MSG
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_LOCK_MSG) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_LOCK_MSG) Do() {
+	p.Srv.NLMPROC4_LOCK_MSG(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_LOCK_MSG{} // XXX
+
+type XdrProc_NLMPROC4_CANCEL_MSG struct {
+	Arg *Nlm4_cancargs
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_CANCEL_MSG) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_CANCEL_MSG) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_CANCEL_MSG) Proc() uint32 { return 8 }
+func (XdrProc_NLMPROC4_CANCEL_MSG) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_CANCEL_MSG) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_CANCEL_MSG) ProcName() string { return "NLMPROC4_CANCEL_MSG" }
+func (p *XdrProc_NLMPROC4_CANCEL_MSG) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_cancargs)
+	}
+	return XDR_Nlm4_cancargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_CANCEL_MSG) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_CANCEL_MSG{} // XXX
+
+type xdrSrvProc_NLMPROC4_CANCEL_MSG struct {
+	XdrProc_NLMPROC4_CANCEL_MSG
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_CANCEL_MSG) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_CANCEL_MSG) Do() {
+	p.Srv.NLMPROC4_CANCEL_MSG(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_CANCEL_MSG{} // XXX
+
+type XdrProc_NLMPROC4_UNLOCK_MSG struct {
+	Arg *Nlm4_unlockargs
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_UNLOCK_MSG) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_UNLOCK_MSG) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_UNLOCK_MSG) Proc() uint32 { return 9 }
+func (XdrProc_NLMPROC4_UNLOCK_MSG) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_UNLOCK_MSG) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_UNLOCK_MSG) ProcName() string { return "NLMPROC4_UNLOCK_MSG" }
+func (p *XdrProc_NLMPROC4_UNLOCK_MSG) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_unlockargs)
+	}
+	return XDR_Nlm4_unlockargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_UNLOCK_MSG) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_UNLOCK_MSG{} // XXX
+
+type xdrSrvProc_NLMPROC4_UNLOCK_MSG struct {
+	XdrProc_NLMPROC4_UNLOCK_MSG
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_UNLOCK_MSG) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_UNLOCK_MSG) Do() {
+	p.Srv.NLMPROC4_UNLOCK_MSG(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_UNLOCK_MSG{} // XXX
+
+type XdrProc_NLMPROC4_GRANTED_MSG struct {
+	Arg *Nlm4_testargs
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_GRANTED_MSG) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_GRANTED_MSG) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_GRANTED_MSG) Proc() uint32 { return 10 }
+func (XdrProc_NLMPROC4_GRANTED_MSG) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_GRANTED_MSG) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_GRANTED_MSG) ProcName() string { return "NLMPROC4_GRANTED_MSG" }
+func (p *XdrProc_NLMPROC4_GRANTED_MSG) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_testargs)
+	}
+	return XDR_Nlm4_testargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_GRANTED_MSG) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_GRANTED_MSG{} // XXX
+
+type xdrSrvProc_NLMPROC4_GRANTED_MSG struct {
+	XdrProc_NLMPROC4_GRANTED_MSG
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_GRANTED_MSG) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_GRANTED_MSG) Do() {
+	p.Srv.NLMPROC4_GRANTED_MSG(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_GRANTED_MSG{} // XXX
+
+type XdrProc_NLMPROC4_TEST_RES struct {
+	Arg *Nlm4_testres
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_TEST_RES) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_TEST_RES) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_TEST_RES) Proc() uint32 { return 11 }
+func (XdrProc_NLMPROC4_TEST_RES) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_TEST_RES) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_TEST_RES) ProcName() string { return "NLMPROC4_TEST_RES" }
+func (p *XdrProc_NLMPROC4_TEST_RES) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_testres)
+	}
+	return XDR_Nlm4_testres(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_TEST_RES) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_TEST_RES{} // XXX
+
+type xdrSrvProc_NLMPROC4_TEST_RES struct {
+	XdrProc_NLMPROC4_TEST_RES
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_TEST_RES) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_TEST_RES) Do() {
+	p.Srv.NLMPROC4_TEST_RES(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_TEST_RES{} // XXX
+
+type XdrProc_NLMPROC4_LOCK_RES struct {
+	Arg *Nlm4_res
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_LOCK_RES) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_LOCK_RES) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_LOCK_RES) Proc() uint32 { return 12 }
+func (XdrProc_NLMPROC4_LOCK_RES) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_LOCK_RES) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_LOCK_RES) ProcName() string { return "NLMPROC4_LOCK_RES" }
+func (p *XdrProc_NLMPROC4_LOCK_RES) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_LOCK_RES) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_LOCK_RES{} // XXX
+
+type xdrSrvProc_NLMPROC4_LOCK_RES struct {
+	XdrProc_NLMPROC4_LOCK_RES
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_LOCK_RES) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_LOCK_RES) Do() {
+	p.Srv.NLMPROC4_LOCK_RES(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_LOCK_RES{} // XXX
+
+type XdrProc_NLMPROC4_CANCEL_RES struct {
+	Arg *Nlm4_res
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_CANCEL_RES) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_CANCEL_RES) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_CANCEL_RES) Proc() uint32 { return 13 }
+func (XdrProc_NLMPROC4_CANCEL_RES) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_CANCEL_RES) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_CANCEL_RES) ProcName() string { return "NLMPROC4_CANCEL_RES" }
+func (p *XdrProc_NLMPROC4_CANCEL_RES) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_CANCEL_RES) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_CANCEL_RES{} // XXX
+
+type xdrSrvProc_NLMPROC4_CANCEL_RES struct {
+	XdrProc_NLMPROC4_CANCEL_RES
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_CANCEL_RES) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_CANCEL_RES) Do() {
+	p.Srv.NLMPROC4_CANCEL_RES(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_CANCEL_RES{} // XXX
+
+type XdrProc_NLMPROC4_UNLOCK_RES struct {
+	Arg *Nlm4_res
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_UNLOCK_RES) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_UNLOCK_RES) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_UNLOCK_RES) Proc() uint32 { return 14 }
+func (XdrProc_NLMPROC4_UNLOCK_RES) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_UNLOCK_RES) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_UNLOCK_RES) ProcName() string { return "NLMPROC4_UNLOCK_RES" }
+func (p *XdrProc_NLMPROC4_UNLOCK_RES) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_UNLOCK_RES) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_UNLOCK_RES{} // XXX
+
+type xdrSrvProc_NLMPROC4_UNLOCK_RES struct {
+	XdrProc_NLMPROC4_UNLOCK_RES
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_UNLOCK_RES) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_UNLOCK_RES) Do() {
+	p.Srv.NLMPROC4_UNLOCK_RES(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_UNLOCK_RES{} // XXX
+
+type XdrProc_NLMPROC4_GRANTED_RES struct {
+	Arg *Nlm4_res
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_GRANTED_RES) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_GRANTED_RES) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_GRANTED_RES) Proc() uint32 { return 15 }
+func (XdrProc_NLMPROC4_GRANTED_RES) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_GRANTED_RES) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_GRANTED_RES) ProcName() string { return "NLMPROC4_GRANTED_RES" }
+func (p *XdrProc_NLMPROC4_GRANTED_RES) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_GRANTED_RES) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_GRANTED_RES{} // XXX
+
+type xdrSrvProc_NLMPROC4_GRANTED_RES struct {
+	XdrProc_NLMPROC4_GRANTED_RES
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_GRANTED_RES) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_GRANTED_RES) Do() {
+	p.Srv.NLMPROC4_GRANTED_RES(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_GRANTED_RES{} // XXX
+
+type XdrProc_NLMPROC4_SM_NOTIFY struct {
+	Arg *Nlm4_sm_status
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_SM_NOTIFY) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_SM_NOTIFY) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_SM_NOTIFY) Proc() uint32 { return 16 }
+func (XdrProc_NLMPROC4_SM_NOTIFY) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_SM_NOTIFY) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_SM_NOTIFY) ProcName() string { return "NLMPROC4_SM_NOTIFY" }
+func (p *XdrProc_NLMPROC4_SM_NOTIFY) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_sm_status)
+	}
+	return XDR_Nlm4_sm_status(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_SM_NOTIFY) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_SM_NOTIFY{} // XXX
+
+type xdrSrvProc_NLMPROC4_SM_NOTIFY struct {
+	XdrProc_NLMPROC4_SM_NOTIFY
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_SM_NOTIFY) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_SM_NOTIFY) Do() {
+	p.Srv.NLMPROC4_SM_NOTIFY(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_SM_NOTIFY{} // XXX
+
+type XdrProc_NLMPROC4_SHARE struct {
+	Arg *Nlm4_shareargs
+	Res *Nlm4_shareres
+}
+func (XdrProc_NLMPROC4_SHARE) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_SHARE) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_SHARE) Proc() uint32 { return 20 }
+func (XdrProc_NLMPROC4_SHARE) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_SHARE) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_SHARE) ProcName() string { return "NLMPROC4_SHARE" }
+func (p *XdrProc_NLMPROC4_SHARE) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_shareargs)
+	}
+	return XDR_Nlm4_shareargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_SHARE) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Nlm4_shareres)
+	}
+	return XDR_Nlm4_shareres(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_SHARE{} // XXX
+
+type xdrSrvProc_NLMPROC4_SHARE struct {
+	XdrProc_NLMPROC4_SHARE
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_SHARE) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_SHARE) Do() {
+	r := p.Srv.NLMPROC4_SHARE(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_SHARE{} // XXX
+
+type XdrProc_NLMPROC4_UNSHARE struct {
+	Arg *Nlm4_shareargs
+	Res *Nlm4_shareres
+}
+func (XdrProc_NLMPROC4_UNSHARE) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_UNSHARE) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_UNSHARE) Proc() uint32 { return 21 }
+func (XdrProc_NLMPROC4_UNSHARE) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_UNSHARE) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_UNSHARE) ProcName() string { return "NLMPROC4_UNSHARE" }
+func (p *XdrProc_NLMPROC4_UNSHARE) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_shareargs)
+	}
+	return XDR_Nlm4_shareargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_UNSHARE) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Nlm4_shareres)
+	}
+	return XDR_Nlm4_shareres(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_UNSHARE{} // XXX
+
+type xdrSrvProc_NLMPROC4_UNSHARE struct {
+	XdrProc_NLMPROC4_UNSHARE
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_UNSHARE) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_UNSHARE) Do() {
+	r := p.Srv.NLMPROC4_UNSHARE(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_UNSHARE{} // XXX
+
+type XdrProc_NLMPROC4_NM_LOCK struct {
+	Arg *Nlm4_lockargs
+	Res *Nlm4_res
+}
+func (XdrProc_NLMPROC4_NM_LOCK) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_NM_LOCK) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_NM_LOCK) Proc() uint32 { return 22 }
+func (XdrProc_NLMPROC4_NM_LOCK) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_NM_LOCK) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_NM_LOCK) ProcName() string { return "NLMPROC4_NM_LOCK" }
+func (p *XdrProc_NLMPROC4_NM_LOCK) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_lockargs)
+	}
+	return XDR_Nlm4_lockargs(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_NM_LOCK) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Nlm4_res)
+	}
+	return XDR_Nlm4_res(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_NM_LOCK{} // XXX
+
+type xdrSrvProc_NLMPROC4_NM_LOCK struct {
+	XdrProc_NLMPROC4_NM_LOCK
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_NM_LOCK) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_NM_LOCK) Do() {
+	r := p.Srv.NLMPROC4_NM_LOCK(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_NM_LOCK{} // XXX
+
+type XdrProc_NLMPROC4_FREE_ALL struct {
+	Arg *Nlm4_notify
+	Res *XdrVoid
+}
+func (XdrProc_NLMPROC4_FREE_ALL) Prog() uint32 { return 100021 }
+func (XdrProc_NLMPROC4_FREE_ALL) Vers() uint32 { return 4 }
+func (XdrProc_NLMPROC4_FREE_ALL) Proc() uint32 { return 23 }
+func (XdrProc_NLMPROC4_FREE_ALL) ProgName() string { return "NLM_PROG" }
+func (XdrProc_NLMPROC4_FREE_ALL) VersName() string { return "NLM4_VERS" }
+func (XdrProc_NLMPROC4_FREE_ALL) ProcName() string { return "NLMPROC4_FREE_ALL" }
+func (p *XdrProc_NLMPROC4_FREE_ALL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Nlm4_notify)
+	}
+	return XDR_Nlm4_notify(p.Arg)
+}
+func (p *XdrProc_NLMPROC4_FREE_ALL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_NLMPROC4_FREE_ALL{} // XXX
+
+type xdrSrvProc_NLMPROC4_FREE_ALL struct {
+	XdrProc_NLMPROC4_FREE_ALL
+	Srv NLM4_VERS
+}
+func (p *xdrSrvProc_NLMPROC4_FREE_ALL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NLM4_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_NLMPROC4_FREE_ALL) Do() {
+	p.Srv.NLMPROC4_FREE_ALL(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_NLMPROC4_FREE_ALL{} // XXX
+
+func init() {
+	XdrCatalog[100021<<32|4] = func(p uint32) XdrProc {
+		switch(p) {
+		case 0:
+			return &XdrProc_NLMPROC4_NULL{}
+		case 1:
+			return &XdrProc_NLMPROC4_TEST{}
+		case 2:
+			return &XdrProc_NLMPROC4_LOCK{}
+		case 3:
+			return &XdrProc_NLMPROC4_CANCEL{}
+		case 4:
+			return &XdrProc_NLMPROC4_UNLOCK{}
+		case 5:
+			return &XdrProc_NLMPROC4_GRANTED{}
+		case 6:
+			return &XdrProc_NLMPROC4_TEST_MSG{}
+		case 7:
+			return &XdrProc_NLMPROC4_LOCK_MSG{}
+		case 8:
+			return &XdrProc_NLMPROC4_CANCEL_MSG{}
+		case 9:
+			return &XdrProc_NLMPROC4_UNLOCK_MSG{}
+		case 10:
+			return &XdrProc_NLMPROC4_GRANTED_MSG{}
+		case 11:
+			return &XdrProc_NLMPROC4_TEST_RES{}
+		case 12:
+			return &XdrProc_NLMPROC4_LOCK_RES{}
+		case 13:
+			return &XdrProc_NLMPROC4_CANCEL_RES{}
+		case 14:
+			return &XdrProc_NLMPROC4_UNLOCK_RES{}
+		case 15:
+			return &XdrProc_NLMPROC4_GRANTED_RES{}
+		case 16:
+			return &XdrProc_NLMPROC4_SM_NOTIFY{}
+		case 20:
+			return &XdrProc_NLMPROC4_SHARE{}
+		case 21:
+			return &XdrProc_NLMPROC4_UNSHARE{}
+		case 22:
+			return &XdrProc_NLMPROC4_NM_LOCK{}
+		case 23:
+			return &XdrProc_NLMPROC4_FREE_ALL{}
+		}
+		return nil
+	}
+}
+
+type NLM4_VERS_Server struct {
+	Srv NLM4_VERS
+}
+func (NLM4_VERS_Server) Prog() uint32 { return 100021 }
+func (NLM4_VERS_Server) Vers() uint32 { return 4 }
+func (NLM4_VERS_Server) ProgName() string { return "NLM_PROG" }
+func (NLM4_VERS_Server) VersName() string { return "NLM4_VERS" }
+func (s NLM4_VERS_Server) GetProc(p uint32) XdrSrvProc {
+	switch p {
+	case 0:  // NLMPROC4_NULL
+		return &xdrSrvProc_NLMPROC4_NULL{ Srv: s.Srv }
+	case 1:  // NLMPROC4_TEST
+		return &xdrSrvProc_NLMPROC4_TEST{ Srv: s.Srv }
+	case 2:  // NLMPROC4_LOCK
+		return &xdrSrvProc_NLMPROC4_LOCK{ Srv: s.Srv }
+	case 3:  // NLMPROC4_CANCEL
+		return &xdrSrvProc_NLMPROC4_CANCEL{ Srv: s.Srv }
+	case 4:  // NLMPROC4_UNLOCK
+		return &xdrSrvProc_NLMPROC4_UNLOCK{ Srv: s.Srv }
+	case 5:  // NLMPROC4_GRANTED
+		return &xdrSrvProc_NLMPROC4_GRANTED{ Srv: s.Srv }
+	case 6:  // NLMPROC4_TEST_MSG
+		return &xdrSrvProc_NLMPROC4_TEST_MSG{ Srv: s.Srv }
+	case 7:  // NLMPROC4_LOCK_MSG
+		return &xdrSrvProc_NLMPROC4_LOCK_MSG{ Srv: s.Srv }
+	case 8:  // NLMPROC4_CANCEL_MSG
+		return &xdrSrvProc_NLMPROC4_CANCEL_MSG{ Srv: s.Srv }
+	case 9:  // NLMPROC4_UNLOCK_MSG
+		return &xdrSrvProc_NLMPROC4_UNLOCK_MSG{ Srv: s.Srv }
+	case 10:  // NLMPROC4_GRANTED_MSG
+		return &xdrSrvProc_NLMPROC4_GRANTED_MSG{ Srv: s.Srv }
+	case 11:  // NLMPROC4_TEST_RES
+		return &xdrSrvProc_NLMPROC4_TEST_RES{ Srv: s.Srv }
+	case 12:  // NLMPROC4_LOCK_RES
+		return &xdrSrvProc_NLMPROC4_LOCK_RES{ Srv: s.Srv }
+	case 13:  // NLMPROC4_CANCEL_RES
+		return &xdrSrvProc_NLMPROC4_CANCEL_RES{ Srv: s.Srv }
+	case 14:  // NLMPROC4_UNLOCK_RES
+		return &xdrSrvProc_NLMPROC4_UNLOCK_RES{ Srv: s.Srv }
+	case 15:  // NLMPROC4_GRANTED_RES
+		return &xdrSrvProc_NLMPROC4_GRANTED_RES{ Srv: s.Srv }
+	case 16:  // NLMPROC4_SM_NOTIFY
+		return &xdrSrvProc_NLMPROC4_SM_NOTIFY{ Srv: s.Srv }
+	case 20:  // NLMPROC4_SHARE
+		return &xdrSrvProc_NLMPROC4_SHARE{ Srv: s.Srv }
+	case 21:  // NLMPROC4_UNSHARE
+		return &xdrSrvProc_NLMPROC4_UNSHARE{ Srv: s.Srv }
+	case 22:  // NLMPROC4_NM_LOCK
+		return &xdrSrvProc_NLMPROC4_NM_LOCK{ Srv: s.Srv }
+	case 23:  // NLMPROC4_FREE_ALL
+		return &xdrSrvProc_NLMPROC4_FREE_ALL{ Srv: s.Srv }
+	default:
+		return nil
+	}
+}
+var _ XdrSrv = NLM4_VERS_Server{} // XXX
+
+type NLM4_VERS_Client struct {
+	Send XdrSendCall
+	Ctx context.Context
+}
+var _ NLM4_VERS = NLM4_VERS_Client{} // XXX
+func (c NLM4_VERS_Client) WithContext(ctx context.Context) NLM4_VERS {
+	c.Ctx = ctx
+	return c
+}
+func (c NLM4_VERS_Client) NLMPROC4_NULL() {
+	var proc XdrProc_NLMPROC4_NULL
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_TEST(a1 Nlm4_testargs) Nlm4_testres {
+	var proc XdrProc_NLMPROC4_TEST
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NLM4_VERS_Client) NLMPROC4_LOCK(a1 Nlm4_lockargs) Nlm4_res {
+	var proc XdrProc_NLMPROC4_LOCK
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NLM4_VERS_Client) NLMPROC4_CANCEL(a1 Nlm4_cancargs) Nlm4_res {
+	var proc XdrProc_NLMPROC4_CANCEL
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NLM4_VERS_Client) NLMPROC4_UNLOCK(a1 Nlm4_unlockargs) Nlm4_res {
+	var proc XdrProc_NLMPROC4_UNLOCK
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NLM4_VERS_Client) NLMPROC4_GRANTED(a1 Nlm4_testargs) Nlm4_res {
+	var proc XdrProc_NLMPROC4_GRANTED
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NLM4_VERS_Client) NLMPROC4_TEST_MSG(a1 Nlm4_testargs) {
+	var proc XdrProc_NLMPROC4_TEST_MSG
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_LOCK_MSG(a1 Nlm4_lockargs) {
+	var proc XdrProc_NLMPROC4_LOCK_MSG
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_CANCEL_MSG(a1 Nlm4_cancargs) {
+	var proc XdrProc_NLMPROC4_CANCEL_MSG
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_UNLOCK_MSG(a1 Nlm4_unlockargs) {
+	var proc XdrProc_NLMPROC4_UNLOCK_MSG
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_GRANTED_MSG(a1 Nlm4_testargs) {
+	var proc XdrProc_NLMPROC4_GRANTED_MSG
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_TEST_RES(a1 Nlm4_testres) {
+	var proc XdrProc_NLMPROC4_TEST_RES
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_LOCK_RES(a1 Nlm4_res) {
+	var proc XdrProc_NLMPROC4_LOCK_RES
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_CANCEL_RES(a1 Nlm4_res) {
+	var proc XdrProc_NLMPROC4_CANCEL_RES
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_UNLOCK_RES(a1 Nlm4_res) {
+	var proc XdrProc_NLMPROC4_UNLOCK_RES
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_GRANTED_RES(a1 Nlm4_res) {
+	var proc XdrProc_NLMPROC4_GRANTED_RES
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_SM_NOTIFY(a1 Nlm4_sm_status) {
+	var proc XdrProc_NLMPROC4_SM_NOTIFY
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NLM4_VERS_Client) NLMPROC4_SHARE(a1 Nlm4_shareargs) Nlm4_shareres {
+	var proc XdrProc_NLMPROC4_SHARE
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NLM4_VERS_Client) NLMPROC4_UNSHARE(a1 Nlm4_shareargs) Nlm4_shareres {
+	var proc XdrProc_NLMPROC4_UNSHARE
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NLM4_VERS_Client) NLMPROC4_NM_LOCK(a1 Nlm4_lockargs) Nlm4_res {
+	var proc XdrProc_NLMPROC4_NM_LOCK
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NLM4_VERS_Client) NLMPROC4_FREE_ALL(a1 Nlm4_notify) {
+	var proc XdrProc_NLMPROC4_FREE_ALL
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+type XdrType_Sm_name = *Sm_name
+func (v *Sm_name) XdrPointer() interface{} { return v }
+func (Sm_name) XdrTypeName() string { return "Sm_name" }
+func (v Sm_name) XdrValue() interface{} { return v }
+func (v *Sm_name) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Sm_name) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%smon_name", name), XdrString{&v.Mon_name, SM_MAXSTRLEN})
+}
+func XDR_Sm_name(v *Sm_name) *Sm_name { return v }
+var _XdrNames_Sm_res = map[int32]string{
+	int32(Stat_succ): "stat_succ",
+	int32(Stat_fail): "stat_fail",
+}
+var _XdrValues_Sm_res = map[string]int32{
+	"stat_succ": int32(Stat_succ),
+	"stat_fail": int32(Stat_fail),
+}
+func (Sm_res) XdrEnumNames() map[int32]string {
+	return _XdrNames_Sm_res
+}
+func (v Sm_res) String() string {
+	if s, ok := _XdrNames_Sm_res[int32(v)]; ok {
+		return s
+	}
+	return fmt.Sprintf("Sm_res#%d", v)
+}
+func (v *Sm_res) Scan(ss fmt.ScanState, _ rune) error {
+	if tok, err := ss.Token(true, XdrSymChar); err != nil {
+		return err
+	} else {
+		stok := string(tok)
+		if val, ok := _XdrValues_Sm_res[stok]; ok {
+			*v = Sm_res(val)
+			return nil
+		} else if stok == "Sm_res" {
+			if n, err := fmt.Fscanf(ss, "#%d", (*int32)(v));
+				n == 1 && err == nil {
+				return nil
+			}
+		}
+		return XdrError(fmt.Sprintf("%s is not a valid Sm_res.", stok))
+	}
+}
+func (v Sm_res) GetU32() uint32 { return uint32(v) }
+func (v *Sm_res) SetU32(n uint32) { *v = Sm_res(n) }
+func (v *Sm_res) XdrPointer() interface{} { return v }
+func (Sm_res) XdrTypeName() string { return "Sm_res" }
+func (v Sm_res) XdrValue() interface{} { return v }
+func (v *Sm_res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Sm_res = *Sm_res
+func XDR_Sm_res(v *Sm_res) *Sm_res { return v }
+type XdrType_Sm_stat_res = *Sm_stat_res
+func (v *Sm_stat_res) XdrPointer() interface{} { return v }
+func (Sm_stat_res) XdrTypeName() string { return "Sm_stat_res" }
+func (v Sm_stat_res) XdrValue() interface{} { return v }
+func (v *Sm_stat_res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Sm_stat_res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sres_stat", name), XDR_Sm_res(&v.Res_stat))
+	x.Marshal(x.Sprintf("%sstate", name), XDR_int32(&v.State))
+}
+func XDR_Sm_stat_res(v *Sm_stat_res) *Sm_stat_res { return v }
+type XdrType_Sm_stat = *Sm_stat
+func (v *Sm_stat) XdrPointer() interface{} { return v }
+func (Sm_stat) XdrTypeName() string { return "Sm_stat" }
+func (v Sm_stat) XdrValue() interface{} { return v }
+func (v *Sm_stat) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Sm_stat) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sstate", name), XDR_int32(&v.State))
+}
+func XDR_Sm_stat(v *Sm_stat) *Sm_stat { return v }
+type XdrType_My_id = *My_id
+func (v *My_id) XdrPointer() interface{} { return v }
+func (My_id) XdrTypeName() string { return "My_id" }
+func (v My_id) XdrValue() interface{} { return v }
+func (v *My_id) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *My_id) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%smy_name", name), XdrString{&v.My_name, SM_MAXSTRLEN})
+	x.Marshal(x.Sprintf("%smy_prog", name), XDR_int32(&v.My_prog))
+	x.Marshal(x.Sprintf("%smy_vers", name), XDR_int32(&v.My_vers))
+	x.Marshal(x.Sprintf("%smy_proc", name), XDR_int32(&v.My_proc))
+}
+func XDR_My_id(v *My_id) *My_id { return v }
+type XdrType_Mon_id = *Mon_id
+func (v *Mon_id) XdrPointer() interface{} { return v }
+func (Mon_id) XdrTypeName() string { return "Mon_id" }
+func (v Mon_id) XdrValue() interface{} { return v }
+func (v *Mon_id) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Mon_id) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%smon_name", name), XdrString{&v.Mon_name, SM_MAXSTRLEN})
+	x.Marshal(x.Sprintf("%smy_id", name), XDR_My_id(&v.My_id))
+}
+func XDR_Mon_id(v *Mon_id) *Mon_id { return v }
+type XdrType_Mon = *Mon
+func (v *Mon) XdrPointer() interface{} { return v }
+func (Mon) XdrTypeName() string { return "Mon" }
+func (v Mon) XdrValue() interface{} { return v }
+func (v *Mon) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Mon) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%smon_id", name), XDR_Mon_id(&v.Mon_id))
+	x.Marshal(x.Sprintf("%spriv", name), (*_XdrArray_16_opaque)(&v.Priv))
+}
+func XDR_Mon(v *Mon) *Mon { return v }
+type XdrType_Stat_chge = *Stat_chge
+func (v *Stat_chge) XdrPointer() interface{} { return v }
+func (Stat_chge) XdrTypeName() string { return "Stat_chge" }
+func (v Stat_chge) XdrValue() interface{} { return v }
+func (v *Stat_chge) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Stat_chge) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%smon_name", name), XdrString{&v.Mon_name, SM_MAXSTRLEN})
+	x.Marshal(x.Sprintf("%sstate", name), XDR_int32(&v.State))
+}
+func XDR_Stat_chge(v *Stat_chge) *Stat_chge { return v }
+
+type XdrProc_SM_STAT struct {
+	Arg *Sm_name
+	Res *Sm_stat_res
+}
+func (XdrProc_SM_STAT) Prog() uint32 { return 100024 }
+func (XdrProc_SM_STAT) Vers() uint32 { return 1 }
+func (XdrProc_SM_STAT) Proc() uint32 { return 1 }
+func (XdrProc_SM_STAT) ProgName() string { return "SM_PROG" }
+func (XdrProc_SM_STAT) VersName() string { return "SM_VERS" }
+func (XdrProc_SM_STAT) ProcName() string { return "SM_STAT" }
+func (p *XdrProc_SM_STAT) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Sm_name)
+	}
+	return XDR_Sm_name(p.Arg)
+}
+func (p *XdrProc_SM_STAT) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Sm_stat_res)
+	}
+	return XDR_Sm_stat_res(p.Res)
+}
+var _ XdrProc = &XdrProc_SM_STAT{} // XXX
+
+type xdrSrvProc_SM_STAT struct {
+	XdrProc_SM_STAT
+	Srv SM_VERS
+}
+func (p *xdrSrvProc_SM_STAT) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) SM_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_SM_STAT) Do() {
+	r := p.Srv.SM_STAT(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_SM_STAT{} // XXX
+
+type XdrProc_SM_MON struct {
+	Arg *Mon
+	Res *Sm_stat_res
+}
+func (XdrProc_SM_MON) Prog() uint32 { return 100024 }
+func (XdrProc_SM_MON) Vers() uint32 { return 1 }
+func (XdrProc_SM_MON) Proc() uint32 { return 2 }
+func (XdrProc_SM_MON) ProgName() string { return "SM_PROG" }
+func (XdrProc_SM_MON) VersName() string { return "SM_VERS" }
+func (XdrProc_SM_MON) ProcName() string { return "SM_MON" }
+func (p *XdrProc_SM_MON) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Mon)
+	}
+	return XDR_Mon(p.Arg)
+}
+func (p *XdrProc_SM_MON) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Sm_stat_res)
+	}
+	return XDR_Sm_stat_res(p.Res)
+}
+var _ XdrProc = &XdrProc_SM_MON{} // XXX
+
+type xdrSrvProc_SM_MON struct {
+	XdrProc_SM_MON
+	Srv SM_VERS
+}
+func (p *xdrSrvProc_SM_MON) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) SM_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_SM_MON) Do() {
+	r := p.Srv.SM_MON(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_SM_MON{} // XXX
+
+type XdrProc_SM_UNMON struct {
+	Arg *Mon_id
+	Res *Sm_stat
+}
+func (XdrProc_SM_UNMON) Prog() uint32 { return 100024 }
+func (XdrProc_SM_UNMON) Vers() uint32 { return 1 }
+func (XdrProc_SM_UNMON) Proc() uint32 { return 3 }
+func (XdrProc_SM_UNMON) ProgName() string { return "SM_PROG" }
+func (XdrProc_SM_UNMON) VersName() string { return "SM_VERS" }
+func (XdrProc_SM_UNMON) ProcName() string { return "SM_UNMON" }
+func (p *XdrProc_SM_UNMON) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Mon_id)
+	}
+	return XDR_Mon_id(p.Arg)
+}
+func (p *XdrProc_SM_UNMON) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Sm_stat)
+	}
+	return XDR_Sm_stat(p.Res)
+}
+var _ XdrProc = &XdrProc_SM_UNMON{} // XXX
+
+type xdrSrvProc_SM_UNMON struct {
+	XdrProc_SM_UNMON
+	Srv SM_VERS
+}
+func (p *xdrSrvProc_SM_UNMON) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) SM_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_SM_UNMON) Do() {
+	r := p.Srv.SM_UNMON(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_SM_UNMON{} // XXX
+
+type XdrProc_SM_UNMON_ALL struct {
+	Arg *My_id
+	Res *Sm_stat
+}
+func (XdrProc_SM_UNMON_ALL) Prog() uint32 { return 100024 }
+func (XdrProc_SM_UNMON_ALL) Vers() uint32 { return 1 }
+func (XdrProc_SM_UNMON_ALL) Proc() uint32 { return 4 }
+func (XdrProc_SM_UNMON_ALL) ProgName() string { return "SM_PROG" }
+func (XdrProc_SM_UNMON_ALL) VersName() string { return "SM_VERS" }
+func (XdrProc_SM_UNMON_ALL) ProcName() string { return "SM_UNMON_ALL" }
+func (p *XdrProc_SM_UNMON_ALL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(My_id)
+	}
+	return XDR_My_id(p.Arg)
+}
+func (p *XdrProc_SM_UNMON_ALL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(Sm_stat)
+	}
+	return XDR_Sm_stat(p.Res)
+}
+var _ XdrProc = &XdrProc_SM_UNMON_ALL{} // XXX
+
+type xdrSrvProc_SM_UNMON_ALL struct {
+	XdrProc_SM_UNMON_ALL
+	Srv SM_VERS
+}
+func (p *xdrSrvProc_SM_UNMON_ALL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) SM_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_SM_UNMON_ALL) Do() {
+	r := p.Srv.SM_UNMON_ALL(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_SM_UNMON_ALL{} // XXX
+
+type XdrProc_SM_SIMU_CRASH struct {
+	Arg *XdrVoid
+	Res *XdrVoid
+}
+func (XdrProc_SM_SIMU_CRASH) Prog() uint32 { return 100024 }
+func (XdrProc_SM_SIMU_CRASH) Vers() uint32 { return 1 }
+func (XdrProc_SM_SIMU_CRASH) Proc() uint32 { return 5 }
+func (XdrProc_SM_SIMU_CRASH) ProgName() string { return "SM_PROG" }
+func (XdrProc_SM_SIMU_CRASH) VersName() string { return "SM_VERS" }
+func (XdrProc_SM_SIMU_CRASH) ProcName() string { return "SM_SIMU_CRASH" }
+func (p *XdrProc_SM_SIMU_CRASH) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Arg)
+}
+func (p *XdrProc_SM_SIMU_CRASH) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_SM_SIMU_CRASH{} // XXX
+
+type xdrSrvProc_SM_SIMU_CRASH struct {
+	XdrProc_SM_SIMU_CRASH
+	Srv SM_VERS
+}
+func (p *xdrSrvProc_SM_SIMU_CRASH) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) SM_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_SM_SIMU_CRASH) Do() {
+	p.Srv.SM_SIMU_CRASH()
+}
+var _ XdrSrvProc = &xdrSrvProc_SM_SIMU_CRASH{} // XXX
+
+type XdrProc_SM_NOTIFY struct {
+	Arg *Stat_chge
+	Res *XdrVoid
+}
+func (XdrProc_SM_NOTIFY) Prog() uint32 { return 100024 }
+func (XdrProc_SM_NOTIFY) Vers() uint32 { return 1 }
+func (XdrProc_SM_NOTIFY) Proc() uint32 { return 6 }
+func (XdrProc_SM_NOTIFY) ProgName() string { return "SM_PROG" }
+func (XdrProc_SM_NOTIFY) VersName() string { return "SM_VERS" }
+func (XdrProc_SM_NOTIFY) ProcName() string { return "SM_NOTIFY" }
+func (p *XdrProc_SM_NOTIFY) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(Stat_chge)
+	}
+	return XDR_Stat_chge(p.Arg)
+}
+func (p *XdrProc_SM_NOTIFY) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_SM_NOTIFY{} // XXX
+
+type xdrSrvProc_SM_NOTIFY struct {
+	XdrProc_SM_NOTIFY
+	Srv SM_VERS
+}
+func (p *xdrSrvProc_SM_NOTIFY) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) SM_VERS
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_SM_NOTIFY) Do() {
+	p.Srv.SM_NOTIFY(*p.Arg)
+}
+var _ XdrSrvProc = &xdrSrvProc_SM_NOTIFY{} // XXX
+
+func init() {
+	XdrCatalog[100024<<32|1] = func(p uint32) XdrProc {
+		switch(p) {
+		case 1:
+			return &XdrProc_SM_STAT{}
+		case 2:
+			return &XdrProc_SM_MON{}
+		case 3:
+			return &XdrProc_SM_UNMON{}
+		case 4:
+			return &XdrProc_SM_UNMON_ALL{}
+		case 5:
+			return &XdrProc_SM_SIMU_CRASH{}
+		case 6:
+			return &XdrProc_SM_NOTIFY{}
+		}
+		return nil
+	}
+}
+
+type SM_VERS_Server struct {
+	Srv SM_VERS
+}
+func (SM_VERS_Server) Prog() uint32 { return 100024 }
+func (SM_VERS_Server) Vers() uint32 { return 1 }
+func (SM_VERS_Server) ProgName() string { return "SM_PROG" }
+func (SM_VERS_Server) VersName() string { return "SM_VERS" }
+func (s SM_VERS_Server) GetProc(p uint32) XdrSrvProc {
+	switch p {
+	case 1:  // SM_STAT
+		return &xdrSrvProc_SM_STAT{ Srv: s.Srv }
+	case 2:  // SM_MON
+		return &xdrSrvProc_SM_MON{ Srv: s.Srv }
+	case 3:  // SM_UNMON
+		return &xdrSrvProc_SM_UNMON{ Srv: s.Srv }
+	case 4:  // SM_UNMON_ALL
+		return &xdrSrvProc_SM_UNMON_ALL{ Srv: s.Srv }
+	case 5:  // SM_SIMU_CRASH
+		return &xdrSrvProc_SM_SIMU_CRASH{ Srv: s.Srv }
+	case 6:  // SM_NOTIFY
+		return &xdrSrvProc_SM_NOTIFY{ Srv: s.Srv }
+	default:
+		return nil
+	}
+}
+var _ XdrSrv = SM_VERS_Server{} // XXX
+
+type SM_VERS_Client struct {
+	Send XdrSendCall
+	Ctx context.Context
+}
+var _ SM_VERS = SM_VERS_Client{} // XXX
+func (c SM_VERS_Client) WithContext(ctx context.Context) SM_VERS {
+	c.Ctx = ctx
+	return c
+}
+func (c SM_VERS_Client) SM_STAT(a1 Sm_name) Sm_stat_res {
+	var proc XdrProc_SM_STAT
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c SM_VERS_Client) SM_MON(a1 Mon) Sm_stat_res {
+	var proc XdrProc_SM_MON
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c SM_VERS_Client) SM_UNMON(a1 Mon_id) Sm_stat {
+	var proc XdrProc_SM_UNMON
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c SM_VERS_Client) SM_UNMON_ALL(a1 My_id) Sm_stat {
+	var proc XdrProc_SM_UNMON_ALL
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c SM_VERS_Client) SM_SIMU_CRASH() {
+	var proc XdrProc_SM_SIMU_CRASH
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c SM_VERS_Client) SM_NOTIFY(a1 Stat_chge) {
+	var proc XdrProc_SM_NOTIFY
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+type XdrType_Aclent = *Aclent
+func (v *Aclent) XdrPointer() interface{} { return v }
+func (Aclent) XdrTypeName() string { return "Aclent" }
+func (v Aclent) XdrValue() interface{} { return v }
+func (v *Aclent) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Aclent) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%stype", name), XDR_uint32(&v.Type))
+	x.Marshal(x.Sprintf("%sid", name), XDR_uint32(&v.Id))
+	x.Marshal(x.Sprintf("%sperm", name), XDR_uint32(&v.Perm))
+}
+func XDR_Aclent(v *Aclent) *Aclent { return v }
+type _XdrVec_1024_Aclent []Aclent
+func (_XdrVec_1024_Aclent) XdrBound() uint32 {
+	const bound uint32 = 1024 // Force error if not const or doesn't fit
+	return bound
+}
+func (_XdrVec_1024_Aclent) XdrCheckLen(length uint32) {
+	if length > uint32(1024) {
+		XdrPanic("_XdrVec_1024_Aclent length %d exceeds bound 1024", length)
+	} else if int(length) < 0 {
+		XdrPanic("_XdrVec_1024_Aclent length %d exceeds max int", length)
+	}
+}
+func (v _XdrVec_1024_Aclent) GetVecLen() uint32 { return uint32(len(v)) }
+func (v *_XdrVec_1024_Aclent) SetVecLen(length uint32) {
+	v.XdrCheckLen(length)
+	if int(length) <= cap(*v) {
+		if int(length) != len(*v) {
+			*v = (*v)[:int(length)]
+		}
+		return
+	}
+	newcap := 2*cap(*v)
+	if newcap < int(length) { // also catches overflow where 2*cap < 0
+		newcap = int(length)
+	} else if bound := uint(1024); uint(newcap) > bound {
+		if int(bound) < 0 {
+			bound = ^uint(0) >> 1
+		}
+		newcap = int(bound)
+	}
+	nv := make([]Aclent, int(length), newcap)
+	copy(nv, *v)
+	*v = nv
+}
+func (v *_XdrVec_1024_Aclent) XdrMarshalN(x XDR, name string, n uint32) {
+	v.XdrCheckLen(n)
+	for i := 0; i < int(n); i++ {
+		if (i >= len(*v)) {
+			v.SetVecLen(uint32(i+1))
+		}
+		XDR_Aclent(&(*v)[i]).XdrMarshal(x, x.Sprintf("%s[%d]", name, i))
+	}
+	if int(n) < len(*v) {
+		*v = (*v)[:int(n)]
+	}
+}
+func (v *_XdrVec_1024_Aclent) XdrRecurse(x XDR, name string) {
+	size := XdrSize{ Size: uint32(len(*v)), Bound: 1024 }
+	x.Marshal(name, &size)
+	v.XdrMarshalN(x, name, size.Size)
+}
+func (_XdrVec_1024_Aclent) XdrTypeName() string { return "Aclent<>" }
+func (v *_XdrVec_1024_Aclent) XdrPointer() interface{} { return (*[]Aclent)(v) }
+func (v _XdrVec_1024_Aclent) XdrValue() interface{} { return ([]Aclent)(v) }
+func (v *_XdrVec_1024_Aclent) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+type XdrType_Secattr = *Secattr
+func (v *Secattr) XdrPointer() interface{} { return v }
+func (Secattr) XdrTypeName() string { return "Secattr" }
+func (v Secattr) XdrValue() interface{} { return v }
+func (v *Secattr) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *Secattr) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%smask", name), XDR_uint32(&v.Mask))
+	x.Marshal(x.Sprintf("%saclcnt", name), XDR_int32(&v.Aclcnt))
+	x.Marshal(x.Sprintf("%saclentp", name), (*_XdrVec_1024_Aclent)(&v.Aclentp))
+	x.Marshal(x.Sprintf("%sdfaclcnt", name), XDR_int32(&v.Dfaclcnt))
+	x.Marshal(x.Sprintf("%sdfaclentp", name), (*_XdrVec_1024_Aclent)(&v.Dfaclentp))
+}
+func XDR_Secattr(v *Secattr) *Secattr { return v }
+type XdrType_GETACL3args = *GETACL3args
+func (v *GETACL3args) XdrPointer() interface{} { return v }
+func (GETACL3args) XdrTypeName() string { return "GETACL3args" }
+func (v GETACL3args) XdrValue() interface{} { return v }
+func (v *GETACL3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *GETACL3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfh", name), XDR_Nfs_fh3(&v.Fh))
+	x.Marshal(x.Sprintf("%smask", name), XDR_uint32(&v.Mask))
+}
+func XDR_GETACL3args(v *GETACL3args) *GETACL3args { return v }
+type XdrType_GETACL3resok = *GETACL3resok
+func (v *GETACL3resok) XdrPointer() interface{} { return v }
+func (GETACL3resok) XdrTypeName() string { return "GETACL3resok" }
+func (v GETACL3resok) XdrValue() interface{} { return v }
+func (v *GETACL3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *GETACL3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sattr", name), XDR_Post_op_attr(&v.Attr))
+	x.Marshal(x.Sprintf("%sacl", name), XDR_Secattr(&v.Acl))
+}
+func XDR_GETACL3resok(v *GETACL3resok) *GETACL3resok { return v }
+func (_ GETACL3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *GETACL3res) Resok() *GETACL3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*GETACL3resok); ok {
+			return v
+		} else {
+			var zero GETACL3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("GETACL3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *GETACL3res) Resfail() *Post_op_attr {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("GETACL3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*Post_op_attr); ok {
+			return v
+		} else {
+			var zero Post_op_attr
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u GETACL3res) XdrValid() bool {
+	return true
+}
+func (u *GETACL3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *GETACL3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *GETACL3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_GETACL3resok(u.Resok())
+	default:
+		return XDR_Post_op_attr(u.Resfail())
+	}
+}
+func (u *GETACL3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_GETACL3res = *GETACL3res
+func (v *GETACL3res) XdrPointer() interface{} { return v }
+func (GETACL3res) XdrTypeName() string { return "GETACL3res" }
+func (v GETACL3res) XdrValue() interface{} { return v }
+func (v *GETACL3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *GETACL3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_GETACL3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_Post_op_attr(u.Resfail()))
+		return
+	}
+}
+func XDR_GETACL3res(v *GETACL3res) *GETACL3res { return v}
+type XdrType_SETACL3args = *SETACL3args
+func (v *SETACL3args) XdrPointer() interface{} { return v }
+func (SETACL3args) XdrTypeName() string { return "SETACL3args" }
+func (v SETACL3args) XdrValue() interface{} { return v }
+func (v *SETACL3args) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *SETACL3args) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sfh", name), XDR_Nfs_fh3(&v.Fh))
+	x.Marshal(x.Sprintf("%sacl", name), XDR_Secattr(&v.Acl))
+}
+func XDR_SETACL3args(v *SETACL3args) *SETACL3args { return v }
+type XdrType_SETACL3resok = *SETACL3resok
+func (v *SETACL3resok) XdrPointer() interface{} { return v }
+func (SETACL3resok) XdrTypeName() string { return "SETACL3resok" }
+func (v SETACL3resok) XdrValue() interface{} { return v }
+func (v *SETACL3resok) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (v *SETACL3resok) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	x.Marshal(x.Sprintf("%sattr", name), XDR_Post_op_attr(&v.Attr))
+}
+func XDR_SETACL3resok(v *SETACL3resok) *SETACL3resok { return v }
+func (_ SETACL3res) XdrValidTags() map[int32]bool {
+	return nil
+}
+func (u *SETACL3res) Resok() *SETACL3resok {
+	switch u.Status {
+	case NFS3_OK:
+		if v, ok := u._u.(*SETACL3resok); ok {
+			return v
+		} else {
+			var zero SETACL3resok
+			u._u = &zero
+			return &zero
+		}
+	default:
+		XdrPanic("SETACL3res.Resok accessed when Status == %v", u.Status)
+		return nil
+	}
+}
+func (u *SETACL3res) Resfail() *Post_op_attr {
+	switch u.Status {
+	case NFS3_OK:
+		XdrPanic("SETACL3res.Resfail accessed when Status == %v", u.Status)
+		return nil
+	default:
+		if v, ok := u._u.(*Post_op_attr); ok {
+			return v
+		} else {
+			var zero Post_op_attr
+			u._u = &zero
+			return &zero
+		}
+	}
+}
+func (u SETACL3res) XdrValid() bool {
+	return true
+}
+func (u *SETACL3res) XdrUnionTag() XdrNum32 {
+	return XDR_Nfsstat3(&u.Status)
+}
+func (u *SETACL3res) XdrUnionTagName() string {
+	return "Status"
+}
+func (u *SETACL3res) XdrUnionBody() XdrType {
+	switch u.Status {
+	case NFS3_OK:
+		return XDR_SETACL3resok(u.Resok())
+	default:
+		return XDR_Post_op_attr(u.Resfail())
+	}
+}
+func (u *SETACL3res) XdrUnionBodyName() string {
+	switch u.Status {
+	case NFS3_OK:
+		return "Resok"
+	default:
+		return "Resfail"
+	}
+}
+type XdrType_SETACL3res = *SETACL3res
+func (v *SETACL3res) XdrPointer() interface{} { return v }
+func (SETACL3res) XdrTypeName() string { return "SETACL3res" }
+func (v SETACL3res) XdrValue() interface{} { return v }
+func (v *SETACL3res) XdrMarshal(x XDR, name string) { x.Marshal(name, v) }
+func (u *SETACL3res) XdrRecurse(x XDR, name string) {
+	if name != "" {
+		name = x.Sprintf("%s.", name)
+	}
+	XDR_Nfsstat3(&u.Status).XdrMarshal(x, x.Sprintf("%sstatus", name))
+	switch u.Status {
+	case NFS3_OK:
+		x.Marshal(x.Sprintf("%sresok", name), XDR_SETACL3resok(u.Resok()))
+		return
+	default:
+		x.Marshal(x.Sprintf("%sresfail", name), XDR_Post_op_attr(u.Resfail()))
+		return
+	}
+}
+func XDR_SETACL3res(v *SETACL3res) *SETACL3res { return v}
+
+type XdrProc_ACLPROC3_NULL struct {
+	Arg *XdrVoid
+	Res *XdrVoid
+}
+func (XdrProc_ACLPROC3_NULL) Prog() uint32 { return 100227 }
+func (XdrProc_ACLPROC3_NULL) Vers() uint32 { return 3 }
+func (XdrProc_ACLPROC3_NULL) Proc() uint32 { return 0 }
+func (XdrProc_ACLPROC3_NULL) ProgName() string { return "NFS_ACL_PROGRAM" }
+func (XdrProc_ACLPROC3_NULL) VersName() string { return "NFS_ACL_V3" }
+func (XdrProc_ACLPROC3_NULL) ProcName() string { return "ACLPROC3_NULL" }
+func (p *XdrProc_ACLPROC3_NULL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Arg)
+}
+func (p *XdrProc_ACLPROC3_NULL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(XdrVoid)
+	}
+	return XDR_XdrVoid(p.Res)
+}
+var _ XdrProc = &XdrProc_ACLPROC3_NULL{} // XXX
+
+type xdrSrvProc_ACLPROC3_NULL struct {
+	XdrProc_ACLPROC3_NULL
+	Srv NFS_ACL_V3
+}
+func (p *xdrSrvProc_ACLPROC3_NULL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_ACL_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_ACLPROC3_NULL) Do() {
+	p.Srv.ACLPROC3_NULL()
+}
+var _ XdrSrvProc = &xdrSrvProc_ACLPROC3_NULL{} // XXX
+
+type XdrProc_ACLPROC3_GETACL struct {
+	Arg *GETACL3args
+	Res *GETACL3res
+}
+func (XdrProc_ACLPROC3_GETACL) Prog() uint32 { return 100227 }
+func (XdrProc_ACLPROC3_GETACL) Vers() uint32 { return 3 }
+func (XdrProc_ACLPROC3_GETACL) Proc() uint32 { return 1 }
+func (XdrProc_ACLPROC3_GETACL) ProgName() string { return "NFS_ACL_PROGRAM" }
+func (XdrProc_ACLPROC3_GETACL) VersName() string { return "NFS_ACL_V3" }
+func (XdrProc_ACLPROC3_GETACL) ProcName() string { return "ACLPROC3_GETACL" }
+func (p *XdrProc_ACLPROC3_GETACL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(GETACL3args)
+	}
+	return XDR_GETACL3args(p.Arg)
+}
+func (p *XdrProc_ACLPROC3_GETACL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(GETACL3res)
+	}
+	return XDR_GETACL3res(p.Res)
+}
+var _ XdrProc = &XdrProc_ACLPROC3_GETACL{} // XXX
+
+type xdrSrvProc_ACLPROC3_GETACL struct {
+	XdrProc_ACLPROC3_GETACL
+	Srv NFS_ACL_V3
+}
+func (p *xdrSrvProc_ACLPROC3_GETACL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_ACL_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_ACLPROC3_GETACL) Do() {
+	r := p.Srv.ACLPROC3_GETACL(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_ACLPROC3_GETACL{} // XXX
+
+type XdrProc_ACLPROC3_SETACL struct {
+	Arg *SETACL3args
+	Res *SETACL3res
+}
+func (XdrProc_ACLPROC3_SETACL) Prog() uint32 { return 100227 }
+func (XdrProc_ACLPROC3_SETACL) Vers() uint32 { return 3 }
+func (XdrProc_ACLPROC3_SETACL) Proc() uint32 { return 2 }
+func (XdrProc_ACLPROC3_SETACL) ProgName() string { return "NFS_ACL_PROGRAM" }
+func (XdrProc_ACLPROC3_SETACL) VersName() string { return "NFS_ACL_V3" }
+func (XdrProc_ACLPROC3_SETACL) ProcName() string { return "ACLPROC3_SETACL" }
+func (p *XdrProc_ACLPROC3_SETACL) GetArg() XdrType {
+	if p.Arg == nil {
+		p.Arg = new(SETACL3args)
+	}
+	return XDR_SETACL3args(p.Arg)
+}
+func (p *XdrProc_ACLPROC3_SETACL) GetRes() XdrType {
+	if p.Res == nil {
+		p.Res = new(SETACL3res)
+	}
+	return XDR_SETACL3res(p.Res)
+}
+var _ XdrProc = &XdrProc_ACLPROC3_SETACL{} // XXX
+
+type xdrSrvProc_ACLPROC3_SETACL struct {
+	XdrProc_ACLPROC3_SETACL
+	Srv NFS_ACL_V3
+}
+func (p *xdrSrvProc_ACLPROC3_SETACL) SetContext(ctx context.Context) {
+	if wc, ok := p.Srv.(interface {
+		WithContext(context.Context) NFS_ACL_V3
+	}); ok {
+		p.Srv = wc.WithContext(ctx)
+	}
+}
+func (p *xdrSrvProc_ACLPROC3_SETACL) Do() {
+	r := p.Srv.ACLPROC3_SETACL(*p.Arg)
+	p.Res = &r
+
+}
+var _ XdrSrvProc = &xdrSrvProc_ACLPROC3_SETACL{} // XXX
+
+func init() {
+	XdrCatalog[100227<<32|3] = func(p uint32) XdrProc {
+		switch(p) {
+		case 0:
+			return &XdrProc_ACLPROC3_NULL{}
+		case 1:
+			return &XdrProc_ACLPROC3_GETACL{}
+		case 2:
+			return &XdrProc_ACLPROC3_SETACL{}
+		}
+		return nil
+	}
+}
+
+type NFS_ACL_V3_Server struct {
+	Srv NFS_ACL_V3
+}
+func (NFS_ACL_V3_Server) Prog() uint32 { return 100227 }
+func (NFS_ACL_V3_Server) Vers() uint32 { return 3 }
+func (NFS_ACL_V3_Server) ProgName() string { return "NFS_ACL_PROGRAM" }
+func (NFS_ACL_V3_Server) VersName() string { return "NFS_ACL_V3" }
+func (s NFS_ACL_V3_Server) GetProc(p uint32) XdrSrvProc {
+	switch p {
+	case 0:  // ACLPROC3_NULL
+		return &xdrSrvProc_ACLPROC3_NULL{ Srv: s.Srv }
+	case 1:  // ACLPROC3_GETACL
+		return &xdrSrvProc_ACLPROC3_GETACL{ Srv: s.Srv }
+	case 2:  // ACLPROC3_SETACL
+		return &xdrSrvProc_ACLPROC3_SETACL{ Srv: s.Srv }
+	default:
+		return nil
+	}
+}
+var _ XdrSrv = NFS_ACL_V3_Server{} // XXX
+
+type NFS_ACL_V3_Client struct {
+	Send XdrSendCall
+	Ctx context.Context
+}
+var _ NFS_ACL_V3 = NFS_ACL_V3_Client{} // XXX
+func (c NFS_ACL_V3_Client) WithContext(ctx context.Context) NFS_ACL_V3 {
+	c.Ctx = ctx
+	return c
+}
+func (c NFS_ACL_V3_Client) ACLPROC3_NULL() {
+	var proc XdrProc_ACLPROC3_NULL
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+}
+func (c NFS_ACL_V3_Client) ACLPROC3_GETACL(a1 GETACL3args) GETACL3res {
+	var proc XdrProc_ACLPROC3_GETACL
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
+func (c NFS_ACL_V3_Client) ACLPROC3_SETACL(a1 SETACL3args) SETACL3res {
+	var proc XdrProc_ACLPROC3_SETACL
+	proc.Arg = &a1
+	if err := c.Send.SendCall(c.Ctx, &proc); err != nil {
+		panic(err)
+	}
+	return *proc.Res
+}
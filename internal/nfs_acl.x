@@ -0,0 +1,71 @@
+/* This is based on the NFSACL side protocol as implemented by Solaris and Linux */
+
+/*
+ * NFSACL v3 Definitions
+ */
+
+const NFS_ACL_MAX_ENTRIES = 1024;
+
+/* Bits of the mask in GETACL3args and secattr */
+const NFS_ACL      = 0x1;
+const NFS_ACLCNT   = 0x2;
+const NFS_DFACL    = 0x4;
+const NFS_DFACLCNT = 0x8;
+
+/* Set in the type of the entries of a default ACL */
+const NFS_ACL_DEFAULT = 0x1000;
+
+struct aclent {
+     unsigned int type;
+     unsigned int id;
+     unsigned int perm;
+};
+
+struct secattr {
+     unsigned int mask;
+     int          aclcnt;
+     aclent       aclentp<NFS_ACL_MAX_ENTRIES>;
+     int          dfaclcnt;
+     aclent       dfaclentp<NFS_ACL_MAX_ENTRIES>;
+};
+
+struct GETACL3args {
+     nfs_fh3      fh;
+     unsigned int mask;
+};
+
+struct GETACL3resok {
+     post_op_attr attr;
+     secattr      acl;
+};
+
+union GETACL3res switch (nfsstat3 status) {
+case NFS3_OK:
+     GETACL3resok resok;
+default:
+     post_op_attr resfail;
+};
+
+struct SETACL3args {
+     nfs_fh3 fh;
+     secattr acl;
+};
+
+struct SETACL3resok {
+     post_op_attr attr;
+};
+
+union SETACL3res switch (nfsstat3 status) {
+case NFS3_OK:
+     SETACL3resok resok;
+default:
+     post_op_attr resfail;
+};
+
+program NFS_ACL_PROGRAM {
+     version NFS_ACL_V3 {
+          void       ACLPROC3_NULL(void)          = 0;
+          GETACL3res ACLPROC3_GETACL(GETACL3args) = 1;
+          SETACL3res ACLPROC3_SETACL(SETACL3args) = 2;
+     } = 3;
+} = 100227;
@@ -0,0 +1,58 @@
+/* This is based on the X/Open XNFS specification, Chapter 11 */
+
+/*
+ * NSM v1 Definitions
+ */
+
+const SM_MAXSTRLEN = 1024;
+
+struct sm_name {
+     string mon_name<SM_MAXSTRLEN>;
+};
+
+enum sm_res {
+     stat_succ = 0,   /* NSM agrees to monitor */
+     stat_fail = 1    /* NSM cannot monitor */
+};
+
+struct sm_stat_res {
+     sm_res res_stat;
+     int    state;
+};
+
+struct sm_stat {
+     int state;
+};
+
+struct my_id {
+     string my_name<SM_MAXSTRLEN>;  /* name of the host to call back */
+     int    my_prog;                 /* RPC program to call back */
+     int    my_vers;
+     int    my_proc;
+};
+
+struct mon_id {
+     string mon_name<SM_MAXSTRLEN>;  /* name of the host to monitor */
+     my_id  my_id;
+};
+
+struct mon {
+     mon_id mon_id;
+     opaque priv[16];                /* passed back in the callback */
+};
+
+struct stat_chge {
+     string mon_name<SM_MAXSTRLEN>;
+     int    state;
+};
+
+program SM_PROG {
+     version SM_VERS {
+          sm_stat_res SM_STAT(sm_name)     = 1;
+          sm_stat_res SM_MON(mon)          = 2;
+          sm_stat     SM_UNMON(mon_id)     = 3;
+          sm_stat     SM_UNMON_ALL(my_id)  = 4;
+          void        SM_SIMU_CRASH(void)  = 5;
+          void        SM_NOTIFY(stat_chge) = 6;
+     } = 1;
+} = 100024;
@@ -0,0 +1,235 @@
+// Package metrics collects per-procedure statistics for the nfs3 and nfs4
+// clients, similar to what nfsstat and mountstats report for the kernel
+// client.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Event describes one completed RPC call.
+type Event struct {
+	Prog, Vers, Proc uint32
+
+	// Ops lists the operations of an NFSv4 COMPOUND, in order.
+	Ops []string
+
+	BytesSent     int
+	BytesReceived int
+	Duration      time.Duration
+
+	// Retries counts retransmissions and GARBAGE_ARGS retries.
+	Retries int
+
+	// Status is the NFS (or MOUNT) status the reply carried, valid when
+	// HasStatus is set.
+	Status    uint32
+	HasStatus bool
+
+	// Err is set when the call failed at the transport or RPC level.
+	Err error
+}
+
+// Name returns a readable name for the call: the procedure name for
+// well-known programs, or the COMPOUND operations joined by '|'.
+func (e *Event) Name() string {
+	if len(e.Ops) > 0 {
+		return strings.Join(e.Ops, "|")
+	}
+
+	return ProcName(e.Prog, e.Vers, e.Proc)
+}
+
+// Observer is told about every call a client makes. It is called from the
+// goroutine that made the call and must be safe for concurrent use.
+type Observer interface {
+	ObserveCall(e *Event)
+}
+
+// ObserverFunc adapts a function to the Observer interface.
+type ObserverFunc func(e *Event)
+
+func (f ObserverFunc) ObserveCall(e *Event) { f(e) }
+
+var procNames = map[uint32]map[uint32][]string{
+	100003: {
+		3: {"NULL", "GETATTR", "SETATTR", "LOOKUP", "ACCESS", "READLINK", "READ", "WRITE",
+			"CREATE", "MKDIR", "SYMLINK", "MKNOD", "REMOVE", "RMDIR", "RENAME", "LINK",
+			"READDIR", "READDIRPLUS", "FSSTAT", "FSINFO", "PATHCONF", "COMMIT"},
+		4: {"NULL", "COMPOUND"},
+	},
+	100005: {
+		3: {"NULL", "MNT", "DUMP", "UMNT", "UMNTALL", "EXPORT"},
+	},
+	100000: {
+		2: {"NULL", "SET", "UNSET", "GETPORT", "DUMP", "CALLIT"},
+		3: {"NULL", "SET", "UNSET", "GETADDR", "DUMP", "CALLIT", "GETTIME", "UADDR2TADDR", "TADDR2UADDR"},
+		4: {"NULL", "SET", "UNSET", "GETADDR", "DUMP", "BCAST", "GETTIME", "UADDR2TADDR",
+			"TADDR2UADDR", "GETVERSADDR", "INDIRECT", "GETADDRLIST", "GETSTAT"},
+	},
+}
+
+// ProcName returns the name of a procedure of a well-known program, or
+// "prog/vers/proc" for anything else.
+func ProcName(prog, vers, proc uint32) string {
+	if names := procNames[prog][vers]; int(proc) < len(names) {
+		return names[proc]
+	}
+
+	return fmt.Sprintf("%d/%d/%d", prog, vers, proc)
+}
+
+// LatencyBuckets are the upper bounds of the latency histogram kept by a
+// Collector. Calls slower than the last bound fall into an overflow bucket.
+var LatencyBuckets = []time.Duration{
+	100 * time.Microsecond,
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+}
+
+// Stats are the counters a Collector keeps for one procedure.
+type Stats struct {
+	Name string
+
+	Calls         uint64
+	Errors        uint64 // transport, RPC and non-zero status failures
+	Retries       uint64
+	BytesSent     uint64
+	BytesReceived uint64
+
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+
+	// Latency has one count per entry of LatencyBuckets plus the overflow.
+	Latency []uint64
+
+	// Statuses counts replies by the NFS status they carried.
+	Statuses map[uint32]uint64
+}
+
+// AvgDuration returns the mean latency of the calls.
+func (s *Stats) AvgDuration() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+
+	return s.TotalDuration / time.Duration(s.Calls)
+}
+
+// Collector is an Observer that keeps per-procedure counters and latency
+// histograms.
+type Collector struct {
+	mu    sync.Mutex
+	stats map[string]*Stats
+}
+
+func NewCollector() *Collector {
+	return &Collector{stats: make(map[string]*Stats)}
+}
+
+func (c *Collector) ObserveCall(e *Event) {
+	name := e.Name()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.stats[name]
+	if !ok {
+		s = &Stats{
+			Name:     name,
+			Latency:  make([]uint64, len(LatencyBuckets)+1),
+			Statuses: make(map[uint32]uint64),
+		}
+		c.stats[name] = s
+	}
+
+	s.Calls++
+	s.Retries += uint64(e.Retries)
+	s.BytesSent += uint64(e.BytesSent)
+	s.BytesReceived += uint64(e.BytesReceived)
+	s.TotalDuration += e.Duration
+	if e.Duration > s.MaxDuration {
+		s.MaxDuration = e.Duration
+	}
+
+	if e.Err != nil || (e.HasStatus && e.Status != 0) {
+		s.Errors++
+	}
+
+	if e.HasStatus {
+		s.Statuses[e.Status]++
+	}
+
+	i := sort.Search(len(LatencyBuckets), func(i int) bool { return e.Duration <= LatencyBuckets[i] })
+	s.Latency[i]++
+}
+
+// Snapshot returns a copy of the counters, sorted by procedure name.
+func (c *Collector) Snapshot() []Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out := make([]Stats, 0, len(c.stats))
+	for _, s := range c.stats {
+		cp := *s
+		cp.Latency = append([]uint64(nil), s.Latency...)
+		cp.Statuses = make(map[uint32]uint64, len(s.Statuses))
+		for k, v := range s.Statuses {
+			cp.Statuses[k] = v
+		}
+		out = append(out, cp)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Reset clears all counters.
+func (c *Collector) Reset() {
+	c.mu.Lock()
+	c.stats = make(map[string]*Stats)
+	c.mu.Unlock()
+}
+
+// WriteTo writes a per-procedure report in the spirit of mountstats.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	for _, s := range c.Snapshot() {
+		fmt.Fprintf(&b, "%s:\n", s.Name)
+		fmt.Fprintf(&b, "\t%d ops (%d errors, %d retries)\n", s.Calls, s.Errors, s.Retries)
+		fmt.Fprintf(&b, "\t%d bytes sent, %d bytes received\n", s.BytesSent, s.BytesReceived)
+		fmt.Fprintf(&b, "\tavg %s, max %s\n", s.AvgDuration(), s.MaxDuration)
+
+		b.WriteString("\tlatency")
+		for i, n := range s.Latency {
+			if n == 0 {
+				continue
+			}
+			if i < len(LatencyBuckets) {
+				fmt.Fprintf(&b, " <=%s:%d", LatencyBuckets[i], n)
+			} else {
+				fmt.Fprintf(&b, " >%s:%d", LatencyBuckets[len(LatencyBuckets)-1], n)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProcName(t *testing.T) {
+	tests := []struct {
+		prog, vers, proc uint32
+		want             string
+	}{
+		{100003, 3, 1, "GETATTR"},
+		{100003, 3, 21, "COMMIT"},
+		{100003, 4, 1, "COMPOUND"},
+		{100005, 3, 1, "MNT"},
+		{100000, 2, 3, "GETPORT"},
+		{100000, 4, 12, "GETSTAT"},
+		{100003, 3, 22, "100003/3/22"},
+		{100003, 2, 1, "100003/2/1"},
+		{100227, 3, 1, "100227/3/1"},
+	}
+
+	for _, tt := range tests {
+		if got := ProcName(tt.prog, tt.vers, tt.proc); got != tt.want {
+			t.Errorf("ProcName(%d, %d, %d) = %q, want %q", tt.prog, tt.vers, tt.proc, got, tt.want)
+		}
+	}
+}
+
+func TestEventName(t *testing.T) {
+	e := &Event{Prog: 100003, Vers: 4, Proc: 1, Ops: []string{"SEQUENCE", "PUTFH", "GETATTR"}}
+	if got := e.Name(); got != "SEQUENCE|PUTFH|GETATTR" {
+		t.Errorf("compound: got %q", got)
+	}
+
+	e = &Event{Prog: 100003, Vers: 3, Proc: 3}
+	if got := e.Name(); got != "LOOKUP" {
+		t.Errorf("lookup: got %q", got)
+	}
+}
+
+func TestCollector(t *testing.T) {
+	c := NewCollector()
+
+	c.ObserveCall(&Event{Prog: 100003, Vers: 3, Proc: 1, BytesSent: 100, BytesReceived: 200,
+		Duration: 50 * time.Microsecond, HasStatus: true})
+	c.ObserveCall(&Event{Prog: 100003, Vers: 3, Proc: 1, BytesSent: 100, BytesReceived: 40,
+		Duration: 3 * time.Millisecond, Retries: 2, Status: 70, HasStatus: true})
+	c.ObserveCall(&Event{Prog: 100003, Vers: 3, Proc: 1,
+		Duration: time.Minute, Err: errors.New("timeout")})
+	c.ObserveCall(&Event{Prog: 100003, Vers: 3, Proc: 3, Duration: time.Millisecond, HasStatus: true, Status: 2})
+
+	stats := c.Snapshot()
+	if len(stats) != 2 || stats[0].Name != "GETATTR" || stats[1].Name != "LOOKUP" {
+		t.Fatalf("snapshot: got %v, want GETATTR and LOOKUP", stats)
+	}
+
+	getattr := stats[0]
+	if getattr.Calls != 3 || getattr.Errors != 2 || getattr.Retries != 2 {
+		t.Errorf("getattr: %d calls, %d errors, %d retries, want 3, 2 and 2", getattr.Calls, getattr.Errors, getattr.Retries)
+	}
+	if getattr.BytesSent != 200 || getattr.BytesReceived != 240 {
+		t.Errorf("getattr: %d bytes sent, %d received, want 200 and 240", getattr.BytesSent, getattr.BytesReceived)
+	}
+	if want := map[uint32]uint64{0: 1, 70: 1}; !reflect.DeepEqual(getattr.Statuses, want) {
+		t.Errorf("getattr statuses: got %v, want %v", getattr.Statuses, want)
+	}
+	if getattr.MaxDuration != time.Minute {
+		t.Errorf("getattr max: got %s, want 1m", getattr.MaxDuration)
+	}
+	if want := (time.Minute + 3*time.Millisecond + 50*time.Microsecond) / 3; getattr.AvgDuration() != want {
+		t.Errorf("getattr avg: got %s, want %s", getattr.AvgDuration(), want)
+	}
+
+	// 50µs in the first bucket, 3ms in the one up to 5ms, a minute in the
+	// overflow.
+	latency := make([]uint64, len(LatencyBuckets)+1)
+	latency[0], latency[5], latency[len(LatencyBuckets)] = 1, 1, 1
+	if !reflect.DeepEqual(getattr.Latency, latency) {
+		t.Errorf("getattr latency: got %v, want %v", getattr.Latency, latency)
+	}
+
+	// A duration equal to a bound falls into that bound's bucket.
+	lookup := stats[1]
+	latency = make([]uint64, len(LatencyBuckets)+1)
+	latency[3] = 1
+	if lookup.Calls != 1 || lookup.Errors != 1 || !reflect.DeepEqual(lookup.Latency, latency) {
+		t.Errorf("lookup: %d calls, %d errors, latency %v, want 1, 1 and %v", lookup.Calls, lookup.Errors, lookup.Latency, latency)
+	}
+
+	// Snapshots are copies.
+	stats[0].Latency[0] = 99
+	stats[0].Statuses[0] = 99
+	if s := c.Snapshot()[0]; s.Latency[0] != 1 || s.Statuses[0] != 1 {
+		t.Error("snapshot shares its counters with the collector")
+	}
+
+	c.Reset()
+	if stats := c.Snapshot(); len(stats) != 0 {
+		t.Errorf("after reset: got %v", stats)
+	}
+}
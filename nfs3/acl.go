@@ -0,0 +1,328 @@
+package nfs3
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aobco/log"
+	"github.com/aobco/nfs/internal"
+)
+
+// ACL entry tags, as in Linux's posix_acl and on the wire.
+const (
+	ACLUserObj  = 0x01
+	ACLUser     = 0x02
+	ACLGroupObj = 0x04
+	ACLGroup    = 0x08
+	ACLMask     = 0x10
+	ACLOther    = 0x20
+)
+
+// ACL entry permission bits.
+const (
+	ACLRead    = 0x4
+	ACLWrite   = 0x2
+	ACLExecute = 0x1
+)
+
+// ACLEntry is one entry of a POSIX ACL. ID is only meaningful for ACLUser and
+// ACLGroup entries.
+type ACLEntry struct {
+	Tag  uint32
+	ID   uint32
+	Perm uint32
+}
+
+// ACL is a POSIX ACL.
+type ACL []ACLEntry
+
+// FileACL holds the ACLs of a file. Only directories have a Default ACL,
+// which new files in them inherit.
+type FileACL struct {
+	Attr PostOpAttr
+
+	Access  ACL
+	Default ACL
+}
+
+// GetACL returns the access and default ACLs of path, read over the NFSACL
+// side protocol. Files without an ACL get the minimal one their mode implies.
+func (v *Target) GetACL(path string) (*FileACL, error) {
+	return v.GetACLContext(context.Background(), path)
+}
+
+// GetACLContext is like GetACL, but gives up once ctx is done.
+func (v *Target) GetACLContext(ctx context.Context, path string) (*FileACL, error) {
+	var acl *FileACL
+	err := v.retryStale(func() (err error) {
+		acl, err = v.getACL(ctx, path)
+		return err
+	}, path)
+
+	return acl, err
+}
+
+func (v *Target) getACL(ctx context.Context, path string) (*FileACL, error) {
+	_, fh, err := v.lookup2(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.getACLFH(ctx, fh, path)
+}
+
+func (v *Target) getACLFH(ctx context.Context, fh []byte, path string) (*FileACL, error) {
+	proc := &internal.XdrProc_ACLPROC3_GETACL{Arg: &internal.GETACL3args{
+		Fh:   internal.Nfs_fh3{Data: fh},
+		Mask: internal.NFS_ACL | internal.NFS_ACLCNT | internal.NFS_DFACL | internal.NFS_DFACLCNT,
+	}}
+
+	err := v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
+	if err != nil {
+		log.Debugf("getacl(%s): %s", path, err.Error())
+		return nil, err
+	}
+
+	res := proc.Res.Resok()
+	v.attrs.postOp(fh, &res.Attr)
+	return &FileACL{
+		Attr:    postOpAttrFrom(&res.Attr),
+		Access:  aclFrom(res.Acl.Aclentp),
+		Default: aclFrom(res.Acl.Dfaclentp),
+	}, nil
+}
+
+// SetACL replaces the ACLs of path. A nil Access or Default leaves that ACL
+// as it is; an empty, non-nil Default removes the default ACL. acl.Attr is
+// ignored.
+//
+// Both ACLs are always sent, as the Linux client does: Linux servers before
+// 6.1 remove a default ACL left out of SETACL. A nil one is read from the
+// server first.
+func (v *Target) SetACL(path string, acl *FileACL) error {
+	return v.SetACLContext(context.Background(), path, acl)
+}
+
+// SetACLContext is like SetACL, but gives up once ctx is done.
+func (v *Target) SetACLContext(ctx context.Context, path string, acl *FileACL) error {
+	return v.retryStale(func() error {
+		return v.setACL(ctx, path, acl)
+	}, path)
+}
+
+func (v *Target) setACL(ctx context.Context, path string, acl *FileACL) error {
+	_, fh, err := v.lookup2(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	access, dfl := acl.Access, acl.Default
+	if access == nil || dfl == nil {
+		cur, err := v.getACLFH(ctx, fh, path)
+		if err != nil {
+			return err
+		}
+		if access == nil {
+			access = cur.Access
+		}
+		if dfl == nil {
+			dfl = cur.Default
+		}
+	}
+
+	attr := internal.Secattr{
+		Mask:      internal.NFS_ACL | internal.NFS_ACLCNT | internal.NFS_DFACL | internal.NFS_DFACLCNT,
+		Aclentp:   aclTo(access, 0),
+		Dfaclentp: aclTo(dfl, internal.NFS_ACL_DEFAULT),
+	}
+	attr.Aclcnt = int32(len(attr.Aclentp))
+	attr.Dfaclcnt = int32(len(attr.Dfaclentp))
+
+	proc := &internal.XdrProc_ACLPROC3_SETACL{Arg: &internal.SETACL3args{
+		Fh:  internal.Nfs_fh3{Data: fh},
+		Acl: attr,
+	}}
+
+	err = v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
+	if err != nil {
+		log.Debugf("setacl(%s): %s", path, err.Error())
+		return err
+	}
+	v.attrs.postOp(fh, &proc.Res.Resok().Attr)
+
+	return nil
+}
+
+func aclFrom(ents []internal.Aclent) ACL {
+	acl := make(ACL, 0, len(ents))
+	for _, e := range ents {
+		acl = append(acl, ACLEntry{
+			Tag:  e.Type &^ internal.NFS_ACL_DEFAULT,
+			ID:   e.Id,
+			Perm: e.Perm,
+		})
+	}
+
+	return acl
+}
+
+// aclTo converts acl for the wire, or'ing flags into every type. The entries
+// are sorted as POSIX ACLs are, and a minimal ACL gets the mask entry Solaris
+// servers insist on, as the Linux client does.
+func aclTo(acl ACL, flags uint32) []internal.Aclent {
+	acl = append(ACL(nil), acl...)
+	sort.SliceStable(acl, func(i, j int) bool {
+		if acl[i].Tag != acl[j].Tag {
+			return acl[i].Tag < acl[j].Tag
+		}
+		return acl[i].ID < acl[j].ID
+	})
+
+	if len(acl) == 3 && acl[1].Tag == ACLGroupObj {
+		acl = ACL{acl[0], acl[1], {Tag: ACLMask, Perm: acl[1].Perm}, acl[2]}
+	}
+
+	ents := make([]internal.Aclent, 0, len(acl))
+	for _, e := range acl {
+		ents = append(ents, internal.Aclent{Type: e.Tag | flags, Id: e.ID, Perm: e.Perm})
+	}
+
+	return ents
+}
+
+// ParseACL parses the text form of an ACL, as written by getfacl and read by
+// setfacl, such as "user::rw-,user:1000:r--,group::r--,mask::r--,other::---".
+// Entries are separated by commas or newlines, tags may be abbreviated to
+// their first letter, and comments run from '#' to the end of the line. Users
+// and groups must be given by number, as names mean nothing to the server.
+func ParseACL(text string) (ACL, error) {
+	var acl ACL
+
+	for _, line := range strings.Split(text, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		for _, field := range strings.Split(line, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+
+			e, err := parseACLEntry(field)
+			if err != nil {
+				return nil, err
+			}
+			acl = append(acl, e)
+		}
+	}
+
+	return acl, nil
+}
+
+func parseACLEntry(field string) (ACLEntry, error) {
+	parts := strings.Split(field, ":")
+	if len(parts) != 3 {
+		return ACLEntry{}, fmt.Errorf("acl entry %q: want tag:qualifier:perms", field)
+	}
+
+	var e ACLEntry
+	switch parts[0] {
+	case "user", "u":
+		e.Tag = ACLUserObj
+	case "group", "g":
+		e.Tag = ACLGroupObj
+	case "mask", "m":
+		e.Tag = ACLMask
+	case "other", "o":
+		e.Tag = ACLOther
+	default:
+		return ACLEntry{}, fmt.Errorf("acl entry %q: unknown tag %q", field, parts[0])
+	}
+
+	if qualifier := parts[1]; qualifier != "" {
+		switch e.Tag {
+		case ACLUserObj:
+			e.Tag = ACLUser
+		case ACLGroupObj:
+			e.Tag = ACLGroup
+		default:
+			return ACLEntry{}, fmt.Errorf("acl entry %q: unexpected qualifier", field)
+		}
+
+		id, err := strconv.ParseUint(qualifier, 10, 32)
+		if err != nil {
+			return ACLEntry{}, fmt.Errorf("acl entry %q: qualifier must be a numeric id", field)
+		}
+		e.ID = uint32(id)
+	}
+
+	for _, c := range parts[2] {
+		switch c {
+		case 'r':
+			e.Perm |= ACLRead
+		case 'w':
+			e.Perm |= ACLWrite
+		case 'x':
+			e.Perm |= ACLExecute
+		case '-':
+		default:
+			return ACLEntry{}, fmt.Errorf("acl entry %q: unknown permission %q", field, c)
+		}
+	}
+
+	return e, nil
+}
+
+// String returns the entry in the text form ParseACL reads.
+func (e ACLEntry) String() string {
+	var tag, qualifier string
+	switch e.Tag {
+	case ACLUserObj:
+		tag = "user"
+	case ACLUser:
+		tag, qualifier = "user", strconv.FormatUint(uint64(e.ID), 10)
+	case ACLGroupObj:
+		tag = "group"
+	case ACLGroup:
+		tag, qualifier = "group", strconv.FormatUint(uint64(e.ID), 10)
+	case ACLMask:
+		tag = "mask"
+	case ACLOther:
+		tag = "other"
+	default:
+		tag = fmt.Sprintf("tag%#x", e.Tag)
+	}
+
+	perm := []byte("---")
+	if e.Perm&ACLRead != 0 {
+		perm[0] = 'r'
+	}
+	if e.Perm&ACLWrite != 0 {
+		perm[1] = 'w'
+	}
+	if e.Perm&ACLExecute != 0 {
+		perm[2] = 'x'
+	}
+
+	return tag + ":" + qualifier + ":" + string(perm)
+}
+
+// String returns the ACL in the comma separated text form ParseACL reads.
+func (a ACL) String() string {
+	ents := make([]string, len(a))
+	for i, e := range a {
+		ents[i] = e.String()
+	}
+
+	return strings.Join(ents, ",")
+}
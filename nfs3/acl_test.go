@@ -0,0 +1,176 @@
+package nfs3
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/aobco/nfs/internal"
+)
+
+func TestParseACL(t *testing.T) {
+	for _, tt := range []struct {
+		text string
+		want string
+	}{
+		{
+			text: "user::rw-,user:1000:r--,group::r--,group:50:rwx,mask::r--,other::---",
+			want: "user::rw-,user:1000:r--,group::r--,group:50:rwx,mask::r--,other::---",
+		},
+		{
+			text: "u::rwx,g::r-x,o::r--",
+			want: "user::rwx,group::r-x,other::r--",
+		},
+		{
+			text: "# file: dir\n# owner: root\nuser::rwx\n\ngroup::--x  # search only\nother::-\n",
+			want: "user::rwx,group::--x,other::---",
+		},
+		{
+			text: " user::r , other::w ",
+			want: "user::r--,other::-w-",
+		},
+		{
+			text: "",
+			want: "",
+		},
+	} {
+		acl, err := ParseACL(tt.text)
+		if err != nil {
+			t.Errorf("ParseACL(%q): %s", tt.text, err)
+			continue
+		}
+		if got := acl.String(); got != tt.want {
+			t.Errorf("ParseACL(%q).String() = %q, want %q", tt.text, got, tt.want)
+		}
+
+		again, err := ParseACL(acl.String())
+		if err != nil || !reflect.DeepEqual(again, acl) {
+			t.Errorf("ParseACL(%q) does not round-trip: %v, %v", acl.String(), again, err)
+		}
+	}
+}
+
+func TestParseACLErrors(t *testing.T) {
+	for _, text := range []string{
+		"user:rw-",
+		"user::rw-:x",
+		"owner::rw-",
+		"mask:5:r--",
+		"other:5:r--",
+		"user:bob:r--",
+		"group:-1:r--",
+		"user:4294967296:r--",
+		"user::rwz",
+	} {
+		if acl, err := ParseACL(text); err == nil {
+			t.Errorf("ParseACL(%q) = %v, want an error", text, acl)
+		}
+	}
+}
+
+func TestACLEntryString(t *testing.T) {
+	for _, tt := range []struct {
+		entry ACLEntry
+		want  string
+	}{
+		{ACLEntry{Tag: ACLUserObj, Perm: ACLRead | ACLWrite | ACLExecute}, "user::rwx"},
+		{ACLEntry{Tag: ACLUser, ID: 0, Perm: ACLRead}, "user:0:r--"},
+		{ACLEntry{Tag: ACLGroup, ID: 4294967295, Perm: ACLExecute}, "group:4294967295:--x"},
+		{ACLEntry{Tag: ACLMask, ID: 7}, "mask::---"},
+		{ACLEntry{Tag: 0x40, Perm: ACLWrite}, "tag0x40::-w-"},
+	} {
+		if got := tt.entry.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.entry, got, tt.want)
+		}
+	}
+}
+
+func TestACLToWire(t *testing.T) {
+	minimal, _ := ParseACL("other::r--,group::r-x,user::rw-")
+	ents := aclFrom(aclTo(minimal, 0))
+	if got, want := ents.String(), "user::rw-,group::r-x,mask::r-x,other::r--"; got != want {
+		t.Errorf("minimal ACL on the wire: %s, want %s", got, want)
+	}
+
+	full, _ := ParseACL("user:7:rwx,user::rw-,user:3:r--,mask::rwx,group::r--,other::---")
+	ents = aclFrom(aclTo(full, 0))
+	if got, want := ents.String(), "user::rw-,user:3:r--,user:7:rwx,group::r--,mask::rwx,other::---"; got != want {
+		t.Errorf("full ACL on the wire: %s, want %s", got, want)
+	}
+}
+
+func TestSetACLSendsBoth(t *testing.T) {
+	const full = internal.NFS_ACL | internal.NFS_ACLCNT | internal.NFS_DFACL | internal.NFS_DFACLCNT
+
+	var (
+		s      = newNFSServer()
+		access = mustParseACL(t, "user::rwx,group::r-x,other::r-x")
+		dfl    = mustParseACL(t, "user::rwx,group::r-x,mask::r-x,other::---")
+		sent   internal.Secattr
+	)
+	s.handleLookup(t, "dir")
+	s.handleProc(&internal.XdrProc_ACLPROC3_GETACL{}, func(args io.Reader) internal.XdrType {
+		var a internal.GETACL3args
+		decodeArgs(t, args, &a)
+
+		res := &internal.GETACL3res{}
+		res.Resok().Acl.Mask = a.Mask
+		res.Resok().Acl.Aclentp = aclTo(access, 0)
+		res.Resok().Acl.Aclcnt = int32(len(res.Resok().Acl.Aclentp))
+		res.Resok().Acl.Dfaclentp = aclTo(dfl, internal.NFS_ACL_DEFAULT)
+		res.Resok().Acl.Dfaclcnt = int32(len(res.Resok().Acl.Dfaclentp))
+		return res
+	})
+	s.handleProc(&internal.XdrProc_ACLPROC3_SETACL{}, func(args io.Reader) internal.XdrType {
+		var a internal.SETACL3args
+		decodeArgs(t, args, &a)
+
+		sent = a.Acl
+		return &internal.SETACL3res{}
+	})
+	v := newTestTarget(t, s.Server)
+
+	newACL := mustParseACL(t, "user::rw-,user:7:r--,group::r--,mask::r--,other::---")
+	for _, tt := range []struct {
+		name        string
+		set         FileACL
+		access, dfl ACL
+		getacls     int
+	}{
+		{"access only", FileACL{Access: newACL}, newACL, dfl, 1},
+		{"default only", FileACL{Default: newACL}, access, newACL, 1},
+		{"both", FileACL{Access: newACL, Default: ACL{}}, newACL, ACL{}, 0},
+	} {
+		before := s.countProc(&internal.XdrProc_ACLPROC3_GETACL{})
+		if err := v.SetACL("/dir", &tt.set); err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+
+		s.mu.Lock()
+		got := sent
+		s.mu.Unlock()
+
+		if got.Mask != full {
+			t.Errorf("%s: mask %#x, want %#x", tt.name, got.Mask, full)
+		}
+		if a, want := aclFrom(got.Aclentp).String(), aclFrom(aclTo(tt.access, 0)).String(); a != want || int(got.Aclcnt) != len(got.Aclentp) {
+			t.Errorf("%s: sent access ACL %s (%d), want %s", tt.name, a, got.Aclcnt, want)
+		}
+		if d, want := aclFrom(got.Dfaclentp).String(), aclFrom(aclTo(tt.dfl, 0)).String(); d != want || int(got.Dfaclcnt) != len(got.Dfaclentp) {
+			t.Errorf("%s: sent default ACL %s (%d), want %s", tt.name, d, got.Dfaclcnt, want)
+		}
+		if n := s.countProc(&internal.XdrProc_ACLPROC3_GETACL{}) - before; n != tt.getacls {
+			t.Errorf("%s: %d GETACL, want %d", tt.name, n, tt.getacls)
+		}
+	}
+}
+
+func mustParseACL(t *testing.T, text string) ACL {
+	t.Helper()
+
+	acl, err := ParseACL(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return acl
+}
@@ -0,0 +1,226 @@
+package nfs3
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aobco/nfs/internal"
+)
+
+// AttrCacheOptions are the timeouts of a Target's attribute cache, as the
+// acregmin, acregmax, acdirmin and acdirmax mount options. Attributes are
+// trusted for a tenth of the time since the file last changed, bounded by the
+// minimum and maximum for regular files or for directories.
+type AttrCacheOptions struct {
+	RegMin time.Duration
+	RegMax time.Duration
+	DirMin time.Duration
+	DirMax time.Duration
+}
+
+// DefaultAttrCacheOptions are the timeouts Targets start with, the defaults of
+// the Linux client.
+var DefaultAttrCacheOptions = AttrCacheOptions{
+	RegMin: 3 * time.Second,
+	RegMax: 60 * time.Second,
+	DirMin: 30 * time.Second,
+	DirMax: 60 * time.Second,
+}
+
+// maxCachedAttrs bounds the number of files whose attributes are cached.
+const maxCachedAttrs = 10240
+
+// SetAttrCache sets the timeouts of the Target's attribute cache, dropping
+// what it holds. nil turns the cache off, like the noac mount option, so that
+// every Getattr asks the server.
+func (v *Target) SetAttrCache(opts *AttrCacheOptions) {
+	v.attrs.setOptions(opts)
+}
+
+// attrCache holds the attributes of files by handle. Attributes come from
+// GETATTR and LOOKUP, and from those every other call returns. A nil
+// attrCache caches nothing.
+type attrCache struct {
+	mu      sync.Mutex
+	opts    *AttrCacheOptions
+	entries map[string]cachedAttr
+}
+
+type cachedAttr struct {
+	attr    Fattr
+	expires time.Time
+}
+
+func newAttrCache(opts *AttrCacheOptions) *attrCache {
+	c := &attrCache{}
+	c.setOptions(opts)
+	return c
+}
+
+func (c *attrCache) setOptions(opts *AttrCacheOptions) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.opts = nil
+	if opts != nil {
+		o := *opts
+		c.opts = &o
+	}
+	c.entries = make(map[string]cachedAttr)
+}
+
+// get returns the attributes of fh, if they are cached and not expired.
+func (c *attrCache) get(fh []byte) (*Fattr, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[string(fh)]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false
+	}
+
+	attr := e.attr
+	return &attr, true
+}
+
+// put caches the attributes of fh.
+func (c *attrCache) put(fh []byte, attr *Fattr) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.opts == nil {
+		return
+	}
+
+	now := time.Now()
+	ttl := c.timeout(attr, now)
+
+	if _, ok := c.entries[string(fh)]; !ok && len(c.entries) >= maxCachedAttrs {
+		c.expire(now)
+	}
+	c.entries[string(fh)] = cachedAttr{attr: *attr, expires: now.Add(ttl)}
+}
+
+// ttl returns how long attributes like attr are trusted, zero when nothing
+// is cached.
+func (c *attrCache) ttl(attr *Fattr) time.Duration {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.opts == nil {
+		return 0
+	}
+
+	return c.timeout(attr, time.Now())
+}
+
+// timeout is ttl, with c.mu held and the cache on.
+func (c *attrCache) timeout(attr *Fattr, now time.Time) time.Duration {
+	lo, hi := c.opts.RegMin, c.opts.RegMax
+	if attr.IsDir() {
+		lo, hi = c.opts.DirMin, c.opts.DirMax
+	}
+	ttl := now.Sub(attr.ModTime()) / 10
+	if ttl < lo {
+		ttl = lo
+	}
+	if ttl > hi {
+		ttl = hi
+	}
+
+	return ttl
+}
+
+// expire drops the expired entries, or all of them if none has expired, to
+// make room.
+func (c *attrCache) expire(now time.Time) {
+	n := len(c.entries)
+	for fh, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, fh)
+		}
+	}
+
+	if len(c.entries) == n {
+		c.entries = make(map[string]cachedAttr)
+	}
+}
+
+func (c *attrCache) invalidate(fh []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, string(fh))
+}
+
+// postOp caches the attributes a reply carried for fh, or forgets those
+// cached when it carried none, the call having possibly changed them.
+func (c *attrCache) postOp(fh []byte, a *internal.Post_op_attr) {
+	if !a.Attributes_follow {
+		c.invalidate(fh)
+		return
+	}
+
+	attr := fattrFrom(a.Attributes())
+	c.put(fh, &attr)
+}
+
+// wcc applies the weak cache consistency data of a call that changed fh. It
+// reports whether the attributes before the call differ from those cached,
+// meaning someone else changed fh in the meantime.
+func (c *attrCache) wcc(fh []byte, w *internal.Wcc_data) bool {
+	changed := false
+	if c != nil && w.Before.Attributes_follow {
+		before := w.Before.Attributes()
+
+		c.mu.Lock()
+		e, ok := c.entries[string(fh)]
+		if ok && (e.attr.Filesize != before.Size ||
+			e.attr.Mtime != NFS3Time(before.Mtime) ||
+			e.attr.Ctime != NFS3Time(before.Ctime)) {
+			changed = true
+			delete(c.entries, string(fh))
+		}
+		c.mu.Unlock()
+	}
+
+	c.postOp(fh, &w.After)
+	return changed
+}
+
+// dirWcc applies the weak cache consistency data of a call that changed the
+// directory dirfh. Should someone else have changed it too, the handles cached
+// under it may be out of date, so they are dropped.
+func (v *Target) dirWcc(dirfh []byte, w *internal.Wcc_data) {
+	if v.attrs.wcc(dirfh, w) {
+		v.evictUnder(dirfh)
+	}
+}
+
+// cacheEntry caches the attributes of a READDIRPLUS entry, when it came with
+// a handle to cache them by.
+func (v *Target) cacheEntry(e *internal.Entryplus3) {
+	if e.Name_handle.Handle_follows && e.Name_attributes.Attributes_follow {
+		v.attrs.postOp(e.Name_handle.Handle().Data, &e.Name_attributes)
+	}
+}
@@ -0,0 +1,269 @@
+package nfs3
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"os/user"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aobco/log"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+// PortPolicy decides whether connections are made from a reserved port.
+type PortPolicy int
+
+const (
+	// ReservedIfRoot binds to a reserved port when running as root, since
+	// most servers reject unprivileged clients by default.
+	ReservedIfRoot PortPolicy = iota
+
+	// ReservedAlways always binds to a reserved port, failing when that is
+	// not permitted.
+	ReservedAlways
+
+	// ReservedNever lets the kernel pick the local port.
+	ReservedNever
+)
+
+var (
+	// DefaultMinReservedPort and DefaultMaxReservedPort bound the reserved
+	// ports tried when DialOptions does not say otherwise. They match the
+	// Linux client's defaults.
+	DefaultMinReservedPort = 665
+	DefaultMaxReservedPort = 1023
+
+	// DefaultReservedPortRetries is how many reserved ports are tried before
+	// giving up.
+	DefaultReservedPortRetries = 64
+)
+
+// DialOptions controls how DialMountWithOptions and NewTargetWithOptions
+// connect to the server. The zero value behaves like DialMount and NewTarget.
+type DialOptions struct {
+	// Dial, when set, opens every connection instead of a net.Dialer, e.g.
+	// through an SSH tunnel or to an in-memory pipe. It is only asked for
+	// "tcp" connections, and the local address and port policy do not apply.
+	Dial func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// NFSPort and MountPort, when not zero, are used instead of asking the
+	// portmapper.
+	NFSPort   int
+	MountPort int
+
+	// ReservedPort selects whether to bind to a port between MinReservedPort
+	// and MaxReservedPort, trying at most ReservedPortRetries ports that are
+	// in use. Zero values select the package defaults.
+	ReservedPort        PortPolicy
+	MinReservedPort     int
+	MaxReservedPort     int
+	ReservedPortRetries int
+
+	// LocalAddr, when set, is the local address connections are made from.
+	LocalAddr net.IP
+
+	// ConnectTimeout bounds each connection attempt, and asking the
+	// portmapper for a port.
+	ConnectTimeout time.Duration
+
+	// Nconnect, Balance and TLSConfig are as for Mount.
+	Nconnect  int
+	Balance   Balance
+	TLSConfig *tls.Config
+}
+
+// DialServiceOptions is like DialServiceTLS, with opts controlling how the
+// portmapper and the service are reached. opts may be nil.
+func DialServiceOptions(ctx context.Context, addr string, prog rpc.Mapping, opts *DialOptions) (*rpc.Client, error) {
+	if opts == nil {
+		opts = &DialOptions{}
+	}
+
+	if opts.TLSConfig != nil && prog.Prot == rpc.IPProtoUDP {
+		return nil, errors.New("RPC-over-TLS requires TCP")
+	}
+
+	if opts.Dial != nil && prog.Prot == rpc.IPProtoUDP {
+		return nil, errors.New("a custom dial function only supports TCP")
+	}
+
+	port := opts.port(prog)
+	if port == 0 {
+		pm, err := opts.dialPortmapper(ctx, addr)
+		if err != nil {
+			log.Errorf("Failed to connect to portmapper: %s", err)
+			return nil, err
+		}
+		defer pm.Close()
+
+		if port, err = opts.resolve(ctx, pm, prog); err != nil {
+			return nil, err
+		}
+
+		if port == 0 {
+			return nil, fmt.Errorf("program %d version %d is not registered for protocol %d", prog.Prog, prog.Vers, prog.Prot)
+		}
+	}
+
+	raddr := net.JoinHostPort(strings.Trim(addr, "[]"), strconv.Itoa(port))
+	conn, err := opts.connect(ctx, networkOf(prog), raddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if prog.Prot == rpc.IPProtoUDP {
+		return rpc.NewUDPClient(conn.(*net.UDPConn)), nil
+	}
+
+	if opts.TLSConfig != nil {
+		tconn, err := rpc.StartTLS(conn, prog.Prog, prog.Vers, opts.TLSConfig)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tconn
+	}
+
+	return rpc.NewClient(conn), nil
+}
+
+// port returns the configured port for prog, or zero to ask the portmapper.
+func (o *DialOptions) port(prog rpc.Mapping) int {
+	switch prog.Prog {
+	case Nfs3Prog:
+		return o.NFSPort
+	case MountProg:
+		return o.MountPort
+	}
+
+	return 0
+}
+
+// dialPortmapper connects to the portmapper over TCP, falling back to UDP
+// unless a custom dial function is set.
+func (o *DialOptions) dialPortmapper(ctx context.Context, host string) (*rpc.Portmapper, error) {
+	addr := net.JoinHostPort(strings.Trim(host, "[]"), strconv.Itoa(rpc.PmapPort))
+
+	conn, err := o.dial(ctx, "tcp", nil, addr)
+	if err == nil {
+		return rpc.NewPortmapper(rpc.NewClient(conn), host), nil
+	}
+	if o.Dial != nil {
+		return nil, err
+	}
+
+	log.Debugf("portmapper unavailable over tcp, trying udp: %s", err)
+	conn, err = o.dial(ctx, "udp", nil, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpc.NewPortmapper(rpc.NewUDPClient(conn.(*net.UDPConn)), host), nil
+}
+
+// resolve asks pm for the port of prog, within ConnectTimeout.
+func (o *DialOptions) resolve(ctx context.Context, pm *rpc.Portmapper, prog rpc.Mapping) (int, error) {
+	if o.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.ConnectTimeout)
+		defer cancel()
+	}
+
+	return pm.ResolveContext(ctx, prog)
+}
+
+// connect dials raddr, from a reserved port if the policy asks for one.
+func (o *DialOptions) connect(ctx context.Context, network, raddr string) (net.Conn, error) {
+	if o.Dial != nil || !o.reserved() {
+		log.Debugf("Connecting to %s from unprivileged port", raddr)
+		return o.dial(ctx, network, o.localAddr(network, 0), raddr)
+	}
+
+	min, max := o.MinReservedPort, o.MaxReservedPort
+	if min == 0 {
+		min = DefaultMinReservedPort
+	}
+	if max == 0 {
+		max = DefaultMaxReservedPort
+	}
+	if max < min {
+		return nil, fmt.Errorf("invalid reserved port range %d-%d", min, max)
+	}
+
+	retries := o.ReservedPortRetries
+	if retries == 0 {
+		retries = DefaultReservedPortRetries
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < retries; i++ {
+		p := min + r.Intn(max-min+1)
+
+		conn, err := o.dial(ctx, network, o.localAddr(network, p), raddr)
+		if err == nil {
+			return conn, nil
+		}
+		// bind error, try again
+		if isAddrInUse(err) {
+			continue
+		}
+
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("no free reserved port in %d-%d after %d tries", min, max, retries)
+}
+
+// reserved reports whether connections should come from a reserved port.
+func (o *DialOptions) reserved() bool {
+	switch o.ReservedPort {
+	case ReservedAlways:
+		return true
+	case ReservedNever:
+		return false
+	}
+
+	// Unless explicitly configured, the target will likely reject connections
+	// from non-privileged ports.
+	usr, err := user.Current()
+	return err == nil && usr.Uid == "0"
+}
+
+func (o *DialOptions) localAddr(network string, port int) net.Addr {
+	if o.LocalAddr == nil && port == 0 {
+		return nil
+	}
+
+	if network == "udp" {
+		return &net.UDPAddr{IP: o.LocalAddr, Port: port}
+	}
+	return &net.TCPAddr{IP: o.LocalAddr, Port: port}
+}
+
+func (o *DialOptions) dial(ctx context.Context, network string, laddr net.Addr, raddr string) (net.Conn, error) {
+	if o.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.ConnectTimeout)
+		defer cancel()
+	}
+
+	if o.Dial != nil {
+		return o.Dial(ctx, network, raddr)
+	}
+
+	d := net.Dialer{LocalAddr: laddr}
+	return d.DialContext(ctx, network, raddr)
+}
+
+func networkOf(prog rpc.Mapping) string {
+	if prog.Prot == rpc.IPProtoUDP {
+		return "udp"
+	}
+	return "tcp"
+}
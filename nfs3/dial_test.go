@@ -0,0 +1,82 @@
+package nfs3
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+// redirect returns a Dial function connecting every address to s, served on
+// loopback, and the addresses it was asked for.
+func redirect(t *testing.T, s *rpc.Server) (func(ctx context.Context, network, addr string) (net.Conn, error), func() []string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go s.Serve(l)
+
+	var (
+		mu     sync.Mutex
+		dialed []string
+	)
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		mu.Lock()
+		dialed = append(dialed, addr)
+		mu.Unlock()
+
+		var d net.Dialer
+		return d.DialContext(ctx, network, l.Addr().String())
+	}
+
+	return dial, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string(nil), dialed...)
+	}
+}
+
+func TestDialFixedPortSkipsPortmapper(t *testing.T) {
+	dial, dialed := redirect(t, rpc.NewServer())
+
+	c, err := DialServiceOptions(context.Background(), "192.0.2.1", rpc.Mapping{Prog: Nfs3Prog, Vers: Nfs3Vers, Prot: rpc.IPProtoTCP},
+		&DialOptions{Dial: dial, NFSPort: 2050})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+
+	if got := dialed(); len(got) != 1 || got[0] != "192.0.2.1:2050" {
+		t.Errorf("dialed %q, want only 192.0.2.1:2050", got)
+	}
+}
+
+func TestDialResolveHonorsConnectTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	s := rpc.NewServer()
+	block := func(call *rpc.Call, args io.Reader) (interface{}, error) {
+		<-release
+		return nil, rpc.ErrSystemErr
+	}
+	s.Register(rpc.PmapProg, rpc.RpcbVers4, rpc.RpcbProcGetAddr, block)
+	s.Register(rpc.PmapProg, rpc.PmapVers, rpc.PmapProcGetPort, block)
+	dial, _ := redirect(t, s)
+
+	start := time.Now()
+	_, err := DialServiceOptions(context.Background(), "192.0.2.1", rpc.Mapping{Prog: Nfs3Prog, Vers: Nfs3Vers, Prot: rpc.IPProtoTCP},
+		&DialOptions{Dial: dial, ConnectTimeout: 100 * time.Millisecond})
+	if err == nil {
+		t.Fatal("dial through a portmapper that does not answer succeeded")
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("dial took %s, want about the connect timeout", d)
+	}
+}
@@ -0,0 +1,258 @@
+package nfs3
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"github.com/aobco/log"
+	"github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+// maxBadCookieRestarts bounds how often a Dir starts over after the server
+// rejects its cookie, so a server that keeps doing so cannot loop forever.
+const maxBadCookieRestarts = 3
+
+// DirOptions controls how OpenDirContext reads a directory.
+type DirOptions struct {
+	// NoAttrs reads with READDIR, which returns only names, file ids and
+	// cookies. Otherwise READDIRPLUS is used, falling back to READDIR when
+	// the server does not support it.
+	NoAttrs bool
+
+	// Cookie and CookieVerf resume a listing where an earlier Dir left off,
+	// as returned by its Cookie method.
+	Cookie     uint64
+	CookieVerf uint64
+
+	// DirCount and MaxCount bound the size of each reply. Zero values select
+	// 10240 and 20480 bytes.
+	DirCount uint32
+	MaxCount uint32
+}
+
+// Dir streams the entries of a directory a reply at a time. A Dir is not safe
+// for concurrent use.
+//
+//	d, err := v.OpenDir("/data")
+//	...
+//	for d.Next() {
+//		e := d.Entry()
+//		...
+//	}
+//	if err := d.Err(); err != nil {
+//		...
+//	}
+type Dir struct {
+	v   *Target
+	ctx context.Context
+	fh  []byte
+
+	plus     bool
+	dirCount uint32
+	maxCount uint32
+
+	cookie   uint64
+	verf     uint64
+	restarts int
+
+	entries []*EntryPlus
+	entry   *EntryPlus
+	eof     bool
+	err     error
+}
+
+// OpenDir opens the directory at path for reading with READDIRPLUS.
+func (v *Target) OpenDir(path string) (*Dir, error) {
+	return v.OpenDirContext(context.Background(), path, nil)
+}
+
+// OpenDirContext is like OpenDir, with opts controlling how the directory is
+// read. ctx bounds every call made by the Dir. opts may be nil.
+func (v *Target) OpenDirContext(ctx context.Context, path string, opts *DirOptions) (*Dir, error) {
+	var d *Dir
+	err := v.retryStale(func() error {
+		_, fh, err := v.lookup2(ctx, path)
+		if err != nil {
+			return err
+		}
+
+		// The first reply is read now, so that a cached handle gone stale
+		// is looked up again rather than failing Next.
+		d = v.openDir(ctx, fh, opts)
+		return d.fill()
+	}, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (v *Target) openDir(ctx context.Context, fh []byte, opts *DirOptions) *Dir {
+	if opts == nil {
+		opts = &DirOptions{}
+	}
+
+	d := &Dir{
+		v:        v,
+		ctx:      ctx,
+		fh:       fh,
+		plus:     !opts.NoAttrs,
+		dirCount: opts.DirCount,
+		maxCount: opts.MaxCount,
+		cookie:   opts.Cookie,
+		verf:     opts.CookieVerf,
+	}
+	if d.dirCount == 0 {
+		d.dirCount = 10240
+	}
+	if d.maxCount == 0 {
+		d.maxCount = 20480
+	}
+
+	return d
+}
+
+// Next advances to the next entry, reading more from the server when needed.
+// It returns false at the end of the directory or on error, which Err then
+// reports.
+func (d *Dir) Next() bool {
+	for len(d.entries) == 0 {
+		if d.eof || d.err != nil {
+			d.entry = nil
+			return false
+		}
+
+		d.err = d.fill()
+	}
+
+	d.entry, d.entries = d.entries[0], d.entries[1:]
+	d.cookie = d.entry.Cookie
+	return true
+}
+
+// Entry returns the entry Next advanced to. Entries read with READDIR carry
+// no attributes or handle.
+func (d *Dir) Entry() *EntryPlus {
+	return d.entry
+}
+
+// Err returns the error that stopped Next, if any.
+func (d *Dir) Err() error {
+	return d.err
+}
+
+// Cookie returns the position after the current entry, to resume from with
+// DirOptions.
+func (d *Dir) Cookie() (cookie, verf uint64) {
+	return d.cookie, d.verf
+}
+
+// fill reads the reply following the current cookie.
+func (d *Dir) fill() error {
+	n := len(d.entries)
+
+	var err error
+	if d.plus {
+		err = d.readDirPlus()
+		if isNotSupported(err) {
+			log.Debugf("readdirplus(%x) not supported, falling back to readdir: %s", d.fh, err)
+			d.plus = false
+			err = d.readDir()
+		}
+	} else {
+		err = d.readDir()
+	}
+
+	if IsBadCookieError(err) && d.cookie != 0 && d.restarts < maxBadCookieRestarts {
+		// The directory changed enough for the server to forget our place.
+		// All that can be done is to start over, so entries may be seen
+		// twice.
+		log.Warnf("readdir(%x): cookie %d rejected, restarting", d.fh, d.cookie)
+		d.restarts++
+		d.cookie, d.verf = 0, 0
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(d.entries) == n && !d.eof {
+		return errors.New("readdir returned no entries before the end of the directory")
+	}
+
+	return nil
+}
+
+func (d *Dir) readDirPlus() error {
+	proc := &internal.XdrProc_NFSPROC3_READDIRPLUS{Arg: &internal.READDIRPLUS3args{
+		Dir:      internal.Nfs_fh3{Data: d.fh},
+		Cookie:   d.cookie,
+		Dircount: d.dirCount,
+		Maxcount: d.maxCount,
+	}}
+	binary.BigEndian.PutUint64(proc.Arg.Cookieverf[:], d.verf)
+
+	err := d.v.callProc(d.ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
+	if err != nil {
+		return err
+	}
+
+	res := proc.Res.Resok()
+	d.v.attrs.postOp(d.fh, &res.Dir_attributes)
+	for e := res.Reply.Entries; e != nil; e = e.Nextentry {
+		d.v.cacheEntry(e)
+		d.entries = append(d.entries, entryPlusFrom(e))
+	}
+	d.verf = binary.BigEndian.Uint64(res.Cookieverf[:])
+	d.eof = res.Reply.Eof
+
+	return nil
+}
+
+func (d *Dir) readDir() error {
+	proc := &internal.XdrProc_NFSPROC3_READDIR{Arg: &internal.READDIR3args{
+		Dir:    internal.Nfs_fh3{Data: d.fh},
+		Cookie: d.cookie,
+		Count:  d.maxCount,
+	}}
+	binary.BigEndian.PutUint64(proc.Arg.Cookieverf[:], d.verf)
+
+	err := d.v.callProc(d.ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
+	if err != nil {
+		return err
+	}
+
+	res := proc.Res.Resok()
+	d.v.attrs.postOp(d.fh, &res.Dir_attributes)
+	for e := res.Reply.Entries; e != nil; e = e.Nextentry {
+		d.entries = append(d.entries, &EntryPlus{
+			FileId:   e.Fileid,
+			FileName: e.Name,
+			Cookie:   e.Cookie,
+		})
+	}
+	d.verf = binary.BigEndian.Uint64(res.Cookieverf[:])
+	d.eof = res.Reply.Eof
+
+	return nil
+}
+
+// isNotSupported reports whether err says the server does not implement a
+// procedure.
+func isNotSupported(err error) bool {
+	if errors.Is(err, rpc.ErrProcUnavail) {
+		return true
+	}
+
+	nfsErr, ok := err.(*Error)
+	return ok && nfsErr.ErrorNum == NFS3ErrNotSupp
+}
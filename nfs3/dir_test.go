@@ -0,0 +1,124 @@
+package nfs3
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/aobco/nfs/internal"
+)
+
+// readAll returns the names of the entries d reads.
+func readAll(d *Dir) ([]string, error) {
+	var names []string
+	for d.Next() {
+		names = append(names, d.Entry().FileName)
+	}
+
+	return names, d.Err()
+}
+
+func TestDirFallsBackToReadDir(t *testing.T) {
+	s := newNFSServer()
+	s.handle(NFSProc3ReadDirPlus, func(args io.Reader) internal.XdrType {
+		var a internal.READDIRPLUS3args
+		decodeArgs(t, args, &a)
+		return &internal.READDIRPLUS3res{Status: internal.NFS3ERR_NOTSUPP}
+	})
+	s.handle(NFSProc3ReadDir, func(args io.Reader) internal.XdrType {
+		var a internal.READDIR3args
+		decodeArgs(t, args, &a)
+
+		res := &internal.READDIR3res{}
+		res.Resok().Reply.Entries = &internal.Entry3{Fileid: 2, Name: "a", Cookie: 1,
+			Nextentry: &internal.Entry3{Fileid: 3, Name: "b", Cookie: 2}}
+		res.Resok().Reply.Eof = true
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	d, err := v.OpenDir("/")
+	if err != nil {
+		t.Fatalf("open: %s", err)
+	}
+
+	names, err := readAll(d)
+	if err != nil || !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("read %q, %v, want a and b", names, err)
+	}
+	if n, m := s.count(NFSProc3ReadDirPlus), s.count(NFSProc3ReadDir); n != 1 || m != 1 {
+		t.Errorf("%d READDIRPLUS, %d READDIR, want 1 and 1", n, m)
+	}
+}
+
+func TestDirRestartsOnBadCookie(t *testing.T) {
+	// The server loses its place after every first reply.
+	s := newNFSServer()
+	s.handle(NFSProc3ReadDir, func(args io.Reader) internal.XdrType {
+		var a internal.READDIR3args
+		decodeArgs(t, args, &a)
+
+		res := &internal.READDIR3res{}
+		if a.Cookie != 0 {
+			res.Status = internal.NFS3ERR_BAD_COOKIE
+			return res
+		}
+		res.Resok().Reply.Entries = &internal.Entry3{Fileid: 2, Name: "a", Cookie: 1}
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	d, err := v.OpenDirContext(context.Background(), "/", &DirOptions{NoAttrs: true})
+	if err != nil {
+		t.Fatalf("open: %s", err)
+	}
+
+	names, err := readAll(d)
+	if !IsBadCookieError(err) {
+		t.Errorf("got %v, want NFS3ERR_BAD_COOKIE once restarts run out", err)
+	}
+	// The first listing and one per restart.
+	if want := maxBadCookieRestarts + 1; len(names) != want {
+		t.Errorf("read %q, want %d times a", names, want)
+	}
+	if n, want := s.count(NFSProc3ReadDir), 2*(maxBadCookieRestarts+1); n != want {
+		t.Errorf("%d READDIR, want %d", n, want)
+	}
+}
+
+func TestOpenDirLooksUpStaleHandle(t *testing.T) {
+	var (
+		s     = newNFSServer()
+		stale = true
+	)
+	s.handleLookup(t, "dir")
+	s.handle(NFSProc3ReadDirPlus, func(args io.Reader) internal.XdrType {
+		var a internal.READDIRPLUS3args
+		decodeArgs(t, args, &a)
+
+		res := &internal.READDIRPLUS3res{}
+		if stale {
+			stale = false
+			res.Status = internal.NFS3ERR_STALE
+			return res
+		}
+		res.Resok().Reply.Entries = &internal.Entryplus3{Fileid: 2, Name: "a", Cookie: 1}
+		res.Resok().Reply.Eof = true
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	d, err := v.OpenDir("/dir")
+	if err != nil {
+		t.Fatalf("open: %s", err)
+	}
+
+	names, err := readAll(d)
+	if err != nil || !reflect.DeepEqual(names, []string{"a"}) {
+		t.Errorf("read %q, %v, want a", names, err)
+	}
+	if n := s.count(NFSProc3Lookup); n != 2 {
+		t.Errorf("%d LOOKUP, want 2", n)
+	}
+}
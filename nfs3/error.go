@@ -2,7 +2,14 @@
 // SPDX-License-Identifier: BSD-2-Clause
 package nfs3
 
-import "os"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/aobco/nfs/nfs3/rpc"
+)
 
 const (
 	NFS3Ok             = 0
@@ -121,3 +128,69 @@ func IsNotDirError(err error) bool {
 
 	return false
 }
+
+func IsBadCookieError(err error) bool {
+	nfsErr, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+
+	if nfsErr.ErrorNum == NFS3ErrBadCookie {
+		return true
+	}
+
+	return false
+}
+
+// NotSyncError is returned by a guarded attribute change when the file's ctime
+// was no longer the one given, as happens when the file changed since its
+// attributes were read.
+type NotSyncError struct {
+	Path  string
+	Ctime NFS3Time
+}
+
+func (e *NotSyncError) Error() string {
+	return fmt.Sprintf("setattr(%s): NFS3ERR_NOT_SYNC: ctime is no longer %d.%09d", e.Path, e.Ctime.Seconds, e.Ctime.Nseconds)
+}
+
+// IsNotSyncError reports whether err is a *NotSyncError.
+func IsNotSyncError(err error) bool {
+	var nsErr *NotSyncError
+	return errors.As(err, &nsErr)
+}
+
+// IsStaleError reports whether the server rejected a file handle as stale or
+// invalid, as it does for files removed or replaced behind the client's back.
+func IsStaleError(err error) bool {
+	nfsErr, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+
+	return nfsErr.ErrorNum == NFS3ErrStale || nfsErr.ErrorNum == NFS3ErrBadHandle
+}
+
+// isTransportError reports whether err came from the connection rather than
+// from the server, so a call may have been carried out without its reply
+// arriving.
+func isTransportError(err error) bool {
+	var (
+		nfsErr *Error
+		rpcErr *rpc.Error
+	)
+
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	case errors.As(err, &nfsErr), errors.As(err, &rpcErr):
+		return false
+	case errors.Is(err, os.ErrPermission), errors.Is(err, os.ErrExist),
+		errors.Is(err, os.ErrNotExist), errors.Is(err, os.ErrInvalid):
+		return false
+	}
+
+	return true
+}
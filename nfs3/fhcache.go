@@ -0,0 +1,170 @@
+package nfs3
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aobco/log"
+)
+
+// The Target caches the handles of files and directories by their path,
+// cleaned and made absolute by cachePath, so that lookups need not walk from
+// the root, nor go to the server at all for a path looked up before.
+// Operations that remove or move a path evict it and everything under it, and
+// handles the server calls stale are evicted and looked up again. Another
+// client may also rename a file over a cached path, leaving its handle valid
+// but for the wrong file, so the handles of files other than directories are
+// only kept for as long as their attributes would be.
+
+// cachePath returns the key p is cached under.
+func cachePath(p string) string {
+	return path.Clean("/" + p)
+}
+
+// cachedFH returns the cached handle of the file at p, a cleaned path.
+func (v *Target) cachedFH(p string) ([]byte, bool) {
+	if p == "/" {
+		return v.fh, true
+	}
+
+	fh, ok := v.fhCache.Get(p)
+	if !ok {
+		return nil, false
+	}
+
+	v.fhMu.Lock()
+	expires, ok := v.fhExpires[p]
+	v.fhMu.Unlock()
+	if ok && time.Now().After(expires) {
+		v.evict(p)
+		return nil, false
+	}
+
+	return fh, true
+}
+
+// cacheFH caches fh as the handle of the file at p, a cleaned path, whose
+// attributes are attr if known. Without them it is taken for a file other
+// than a directory.
+func (v *Target) cacheFH(p string, fh []byte, attr *Fattr) {
+	if attr != nil && attr.IsDir() {
+		v.cacheDirFH(p, fh)
+		return
+	}
+
+	var ttl time.Duration
+	if attr != nil {
+		ttl = v.attrs.ttl(attr)
+	}
+
+	v.fhCache.Add(p, fh)
+
+	v.fhMu.Lock()
+	defer v.fhMu.Unlock()
+
+	now := time.Now()
+	if v.fhExpires == nil {
+		v.fhExpires = make(map[string]time.Time)
+	} else if len(v.fhExpires) >= maxCachedAttrs {
+		for key, expires := range v.fhExpires {
+			if now.After(expires) {
+				delete(v.fhExpires, key)
+			}
+		}
+	}
+	v.fhExpires[p] = now.Add(ttl)
+}
+
+// cacheDirFH caches fh as the handle of the directory at p, a cleaned path,
+// until it is evicted.
+func (v *Target) cacheDirFH(p string, fh []byte) {
+	v.fhCache.Add(p, fh)
+
+	v.fhMu.Lock()
+	delete(v.fhExpires, p)
+	v.fhMu.Unlock()
+}
+
+// evict forgets the handles of p and of everything under it.
+func (v *Target) evict(p string) {
+	p = cachePath(p)
+	if p == "/" {
+		return
+	}
+
+	under := func(key string) bool {
+		return key == p || strings.HasPrefix(key, p+"/")
+	}
+	v.fhCache.RemoveFunc(func(key string, _ []byte) bool {
+		return under(key)
+	})
+
+	v.fhMu.Lock()
+	defer v.fhMu.Unlock()
+
+	for key := range v.fhExpires {
+		if under(key) {
+			delete(v.fhExpires, key)
+		}
+	}
+}
+
+// evictChild forgets name in the directory with handle dirfh, wherever that
+// directory is cached, for operations that only know the directory by its
+// handle.
+func (v *Target) evictChild(dirfh []byte, name string) {
+	var dirs []string
+	v.fhCache.Range(func(key string, value []byte) {
+		if bytes.Equal(value, dirfh) {
+			dirs = append(dirs, key)
+		}
+	})
+	if bytes.Equal(v.fh, dirfh) {
+		dirs = append(dirs, "/")
+	}
+
+	for _, dir := range dirs {
+		v.evict(path.Join(dir, name))
+	}
+}
+
+// evictUnder forgets the handles under the directory with handle dirfh,
+// wherever that directory is cached.
+func (v *Target) evictUnder(dirfh []byte) {
+	var prefixes []string
+	v.fhCache.Range(func(key string, value []byte) {
+		if bytes.Equal(value, dirfh) {
+			prefixes = append(prefixes, key+"/")
+		}
+	})
+	if bytes.Equal(v.fh, dirfh) {
+		prefixes = append(prefixes, "/")
+	}
+
+	v.fhCache.RemoveFunc(func(key string, _ []byte) bool {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// retryStale runs op, which looks up the paths it works on. Should the server
+// call a handle stale, the paths are evicted and op run once more, its
+// lookups then starting afresh from the nearest ancestors still valid.
+func (v *Target) retryStale(op func() error, paths ...string) error {
+	err := op()
+	if IsStaleError(err) {
+		log.Debugf("%s: stale handle, looking up again", strings.Join(paths, ", "))
+		for _, p := range paths {
+			v.evict(p)
+		}
+		err = op()
+	}
+
+	return err
+}
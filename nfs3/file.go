@@ -1,12 +1,17 @@
 package nfs3
 
 import (
+	"context"
+	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
 
 	"github.com/aobco/log"
+	"github.com/aobco/nfs/internal"
 	"github.com/aobco/nfs/nfs3/rpc"
 	"github.com/aobco/nfs/nfs3/xdr"
 )
@@ -22,198 +27,376 @@ type File struct {
 
 	// filehandle to the file
 	fh []byte
+
+	// verf is the write verifier of the server's current boot. pending holds
+	// the data written UNSTABLE since the last COMMIT, to send again should
+	// the server reboot and lose it.
+	verf        [8]byte
+	hasVerf     bool
+	pending     []pendingWrite
+	pendingSize int
+
+	// svid identifies the File as a lock owner, once it took a lock.
+	svid int32
 }
 
+// pendingWrite is a range written UNSTABLE and not yet committed.
+type pendingWrite struct {
+	offset uint64
+	data   []byte
+}
+
+const (
+	// maxUncommitted is how much data a File writes before committing it on
+	// its own, bounding the copies it keeps to send again.
+	maxUncommitted = 32 << 20
+
+	// maxResends bounds how often uncommitted data is sent again because the
+	// write verifier keeps changing.
+	maxResends = 3
+)
+
 // Readlink gets the target of a symlink
 func (f *File) Readlink() (string, error) {
-	type ReadlinkArgs struct {
-		rpc.Header
-		FH []byte
-	}
+	proc := &internal.XdrProc_NFSPROC3_READLINK{Arg: &internal.READLINK3args{
+		Symlink: internal.Nfs_fh3{Data: f.fh},
+	}}
 
-	type ReadlinkRes struct {
-		Attr PostOpAttr
-		data []byte
+	err := f.callProc(context.Background(), proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
 	}
-
-	r, err := f.call(&ReadlinkArgs{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Readlink,
-			Cred:    f.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		FH: f.fh,
-	})
-
 	if err != nil {
 		log.Debugf("readlink(%x): %s", f.fh, err.Error())
 		return "", err
 	}
 
-	readlinkres := &ReadlinkRes{}
-	if err = xdr.Read(r, readlinkres); err != nil {
-		return "", err
-	}
-
-	if readlinkres.data, err = xdr.ReadOpaque(r); err != nil {
-		return "", err
-	}
-
-	return string(readlinkres.data), err
+	f.attrs.postOp(f.fh, &proc.Res.Resok().Symlink_attributes)
+	return proc.Res.Resok().Data, nil
 }
 
 func (f *File) Read(p []byte) (int, error) {
-	type ReadArgs struct {
-		rpc.Header
-		FH     []byte
-		Offset uint64
-		Count  uint32
-	}
-
-	type ReadRes struct {
-		Attr  PostOpAttr
-		Count uint32
-		EOF   uint32
-		Data  struct {
-			Length uint32
-		}
-	}
+	return f.ReadContext(context.Background(), p)
+}
 
+// ReadContext is like Read, but gives up once ctx is done. The data is
+// decoded straight from the connection into p.
+func (f *File) ReadContext(ctx context.Context, p []byte) (int, error) {
 	readSize := min(f.fsinfo.RTPref, uint32(len(p)))
 	log.Debugf("read(%x) len=%d offset=%d", f.fh, readSize, f.curr)
 
-	r, err := f.call(&ReadArgs{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Read,
-			Cred:    f.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		FH:     f.fh,
-		Offset: uint64(f.curr),
-		Count:  readSize,
+	// READ3args: file handle, offset, count
+	args := make([]byte, 0, 4+len(f.fh)+3+12)
+	args = xdr.AppendOpaque(args, f.fh)
+	args = xdr.AppendUint64(args, f.curr)
+	args = xdr.AppendUint32(args, readSize)
+
+	var (
+		n    int
+		eof  bool
+		attr internal.Post_op_attr
+	)
+	err := f.conn().CallInto(ctx, &rpc.Header{
+		Rpcvers: 2,
+		Prog:    Nfs3Prog,
+		Vers:    Nfs3Vers,
+		Proc:    NFSProc3Read,
+		Cred:    f.Auth,
+		Verf:    rpc.AuthNull,
+	}, net.Buffers{args}, func(res io.Reader) error {
+		var err error
+		n, eof, err = decodeRead(res, p[:readSize], &attr)
+		return err
 	})
 
 	if err != nil {
 		log.Debugf("read(%x): %s", f.fh, err.Error())
 		return 0, err
 	}
+	f.attrs.postOp(f.fh, &attr)
 
-	readres := &ReadRes{}
-	if err = xdr.Read(r, readres); err != nil {
-		return 0, err
+	f.curr = f.curr + uint64(n)
+	if eof {
+		return n, io.EOF
 	}
 
-	f.curr = f.curr + uint64(readres.Data.Length)
-	n, err := r.Read(p[:readres.Data.Length])
-	if err != nil {
-		return n, err
-	}
+	return n, nil
+}
 
-	if readres.EOF != 0 {
-		err = io.EOF
+// decodeRead reads a READ3res by hand, copying the data into p and the file's
+// attributes into attr.
+func decodeRead(res io.Reader, p []byte, attr *internal.Post_op_attr) (int, bool, error) {
+	var buf [12]byte
+
+	if _, err := io.ReadFull(res, buf[:4]); err != nil {
+		return 0, false, err
 	}
 
-	return n, err
-}
+	if err := NFS3Error(binary.BigEndian.Uint32(buf[:])); err != nil {
+		return 0, false, err
+	}
 
-func (f *File) Write(p []byte) (int, error) {
-	type WriteArgs struct {
-		rpc.Header
-		FH     []byte
-		Offset uint64
-		Count  uint32
+	if err := xdrMarshal(internal.XdrIn{In: res}, attr); err != nil {
+		return 0, false, err
+	}
 
-		// UNSTABLE(0), DATA_SYNC(1), FILE_SYNC(2) default
-		How      uint32
-		Contents []byte
+	// count, eof, data length
+	if _, err := io.ReadFull(res, buf[:12]); err != nil {
+		return 0, false, err
 	}
 
-	type WriteRes struct {
-		Wcc       WccData
-		Count     uint32
-		How       uint32
-		WriteVerf uint64
+	eof := binary.BigEndian.Uint32(buf[4:]) != 0
+	length := binary.BigEndian.Uint32(buf[8:])
+	if int64(length) > int64(len(p)) {
+		return 0, false, fmt.Errorf("read returned %d bytes, %d were asked for", length, len(p))
 	}
 
+	n, err := io.ReadFull(res, p[:length])
+	return n, eof, err
+}
+
+func (f *File) Write(p []byte) (int, error) {
+	return f.WriteContext(context.Background(), p)
+}
+
+// WriteContext is like Write, but gives up once ctx is done. Data written
+// before ctx was cancelled is reported in the returned count. The payload is
+// sent from p without being copied.
+//
+// Data is written UNSTABLE, and only reaches stable storage on the server
+// after Sync or Close. A copy is kept until then, and sent again if the
+// server reboots in between.
+func (f *File) WriteContext(ctx context.Context, p []byte) (int, error) {
 	totalToWrite := uint32(len(p))
 	written := uint32(0)
 
 	for written = 0; written < totalToWrite; {
 		writeSize := min(f.fsinfo.WTPref, totalToWrite-written)
+		data := p[written : written+writeSize]
 
-		res, err := f.call(&WriteArgs{
-			Header: rpc.Header{
-				Rpcvers: 2,
-				Prog:    Nfs3Prog,
-				Vers:    Nfs3Vers,
-				Proc:    NFSProc3Write,
-				Cred:    f.Auth,
-				Verf:    rpc.AuthNull,
-			},
-			FH:       f.fh,
-			Offset:   f.curr,
-			Count:    writeSize,
-			How:      2,
-			Contents: p[written : written+writeSize],
-		})
-
+		count, committed, verf, err := f.writeAt(ctx, f.curr, data, internal.UNSTABLE)
 		if err != nil {
 			log.Errorf("write(%x): %s", f.fh, err.Error())
 			return int(written), err
 		}
 
-		writeres := &WriteRes{}
-		if err = xdr.Read(res, writeres); err != nil {
-			log.Errorf("write(%x) failed to parse result: %s", f.fh, err.Error())
-			log.Debugf("write(%x) partial result: %+v", f.fh, writeres)
-			return int(written), err
+		if count == 0 {
+			// Sending the rest again would likely get nowhere either.
+			log.Errorf("write(%x): server wrote nothing of %d bytes", f.fh, writeSize)
+			return int(written), io.ErrShortWrite
+		}
+		if count != writeSize {
+			log.Debugf("write(%x) did not write full data payload: sent: %d, written: %d", f.fh, writeSize, count)
 		}
 
-		if writeres.Count != writeSize {
-			log.Debugf("write(%x) did not write full data payload: sent: %d, written: %d", writeSize, writeres.Count)
+		// The chunk joins the pending data before the verifier is checked, so
+		// that a resend the check starts covers it too.
+		if committed == internal.UNSTABLE {
+			f.pending = append(f.pending, pendingWrite{
+				offset: f.curr,
+				data:   append([]byte(nil), data[:count]...),
+			})
+			f.pendingSize += int(count)
+		}
+
+		if err = f.checkVerf(ctx, verf); err != nil {
+			return int(written), err
 		}
 
-		f.curr += uint64(writeres.Count)
-		written += writeres.Count
-		// log.Debugf("write(%x) len=%d new_offset=%d written=%d total=%d", f.fh, totalToWrite, f.curr, writeres.Count, written)
+		f.curr += uint64(count)
+		written += count
+		// log.Debugf("write(%x) len=%d new_offset=%d written=%d total=%d", f.fh, totalToWrite, f.curr, count, written)
+
+		if f.pendingSize >= maxUncommitted {
+			if err = f.SyncContext(ctx); err != nil {
+				return int(written), err
+			}
+		}
 	}
 
 	return int(written), nil
 }
 
-// Close commits the file
-func (f *File) Close() error {
-	type CommitArg struct {
-		rpc.Header
-		FH     []byte
-		Offset uint64
-		Count  uint32
-	}
-
-	_, err := f.call(&CommitArg{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Commit,
-			Cred:    f.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		FH: f.fh,
+// writeAt sends one WRITE of data at offset, returning the count written, how
+// it was committed and the write verifier.
+func (f *File) writeAt(ctx context.Context, offset uint64, data []byte, stable internal.Stable_how) (uint32, internal.Stable_how, [8]byte, error) {
+	// WRITE3args: file handle, offset, count, stable_how and the data
+	args := make([]byte, 0, 4+len(f.fh)+3+20)
+	args = xdr.AppendOpaque(args, f.fh)
+	args = xdr.AppendUint64(args, offset)
+	args = xdr.AppendUint32(args, uint32(len(data)))
+	args = xdr.AppendUint32(args, uint32(stable))
+	args = xdr.AppendUint32(args, uint32(len(data)))
+
+	var (
+		count     uint32
+		committed internal.Stable_how
+		verf      [8]byte
+		wcc       internal.Wcc_data
+	)
+	err := f.conn().CallInto(ctx, &rpc.Header{
+		Rpcvers: 2,
+		Prog:    Nfs3Prog,
+		Vers:    Nfs3Vers,
+		Proc:    NFSProc3Write,
+		Cred:    f.Auth,
+		Verf:    rpc.AuthNull,
+	}, net.Buffers{args, data, xdr.Padding(len(data))}, func(res io.Reader) error {
+		var err error
+		count, committed, verf, err = decodeWrite(res, &wcc)
+		return err
 	})
+	if err == nil {
+		f.attrs.wcc(f.fh, &wcc)
+	}
+
+	return count, committed, verf, err
+}
+
+// decodeWrite reads a WRITE3res by hand and returns the count written, how it
+// was committed and the write verifier. The file's wcc_data goes into wcc.
+func decodeWrite(res io.Reader, wcc *internal.Wcc_data) (uint32, internal.Stable_how, [8]byte, error) {
+	var (
+		buf  [16]byte
+		verf [8]byte
+	)
+
+	if _, err := io.ReadFull(res, buf[:4]); err != nil {
+		return 0, 0, verf, err
+	}
+
+	if err := NFS3Error(binary.BigEndian.Uint32(buf[:])); err != nil {
+		return 0, 0, verf, err
+	}
 
+	if err := xdrMarshal(internal.XdrIn{In: res}, wcc); err != nil {
+		return 0, 0, verf, err
+	}
+
+	// count, committed, verifier
+	if _, err := io.ReadFull(res, buf[:16]); err != nil {
+		return 0, 0, verf, err
+	}
+	copy(verf[:], buf[8:16])
+
+	return binary.BigEndian.Uint32(buf[:]), internal.Stable_how(binary.BigEndian.Uint32(buf[4:])), verf, nil
+}
+
+// checkVerf records the write verifier of a reply. A verifier different from
+// the last one means the server rebooted and may have lost the uncommitted
+// data, which is then sent again.
+func (f *File) checkVerf(ctx context.Context, verf [8]byte) error {
+	if !f.hasVerf {
+		f.verf, f.hasVerf = verf, true
+		return nil
+	}
+
+	if verf == f.verf {
+		return nil
+	}
+
+	log.Warnf("write(%x): write verifier changed, server rebooted, resending %d bytes", f.fh, f.pendingSize)
+	f.verf = verf
+	return f.resend(ctx)
+}
+
+// resend writes the uncommitted data again, until it was all written under
+// the same verifier.
+func (f *File) resend(ctx context.Context) error {
+	for attempt := 0; attempt < maxResends; attempt++ {
+		done, err := f.resendOnce(ctx)
+		if err != nil || done {
+			return err
+		}
+	}
+
+	return fmt.Errorf("write(%x): write verifier kept changing while resending uncommitted data", f.fh)
+}
+
+// resendOnce writes the uncommitted data again, returning false when the
+// verifier changed on the way.
+func (f *File) resendOnce(ctx context.Context) (bool, error) {
+	for _, w := range f.pending {
+		for sent := uint32(0); sent < uint32(len(w.data)); {
+			size := min(f.fsinfo.WTPref, uint32(len(w.data))-sent)
+
+			count, _, verf, err := f.writeAt(ctx, w.offset+uint64(sent), w.data[sent:sent+size], internal.UNSTABLE)
+			if err != nil {
+				return false, err
+			}
+			if verf != f.verf {
+				f.verf = verf
+				return false, nil
+			}
+			if count == 0 {
+				return false, io.ErrShortWrite
+			}
+
+			sent += count
+		}
+	}
+
+	return true, nil
+}
+
+// Sync commits the data written to stable storage on the server.
+func (f *File) Sync() error {
+	return f.SyncContext(context.Background())
+}
+
+// SyncContext is like Sync, but gives up once ctx is done.
+func (f *File) SyncContext(ctx context.Context) error {
+	for attempt := 0; len(f.pending) > 0; attempt++ {
+		if attempt == maxResends {
+			return fmt.Errorf("commit(%x): write verifier kept changing", f.fh)
+		}
+
+		verf, err := f.commit(ctx)
+		if err != nil {
+			return err
+		}
+
+		if verf == f.verf {
+			f.pending, f.pendingSize = nil, 0
+			return nil
+		}
+
+		log.Warnf("commit(%x): write verifier changed, server rebooted, resending %d bytes", f.fh, f.pendingSize)
+		f.verf = verf
+		if err = f.resend(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commit sends a COMMIT for the whole file and returns the write verifier.
+func (f *File) commit(ctx context.Context) ([8]byte, error) {
+	proc := &internal.XdrProc_NFSPROC3_COMMIT{Arg: &internal.COMMIT3args{
+		File: internal.Nfs_fh3{Data: f.fh},
+	}}
+
+	err := f.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
 		log.Debugf("commit(%x): %s", f.fh, err.Error())
-		return err
+		return [8]byte{}, err
 	}
 
-	return nil
+	f.attrs.wcc(f.fh, &proc.Res.Resok().File_wcc)
+	return proc.Res.Resok().Verf, nil
+}
+
+// Close commits the file and releases its locks.
+func (f *File) Close() error {
+	err := f.Sync()
+	if uerr := f.unlockAll(); err == nil {
+		err = uerr
+	}
+	return err
 }
 
 // Seek sets the offset for the next Read or Write to offset, interpreted according to whence.
@@ -244,20 +427,62 @@ func (f *File) Seek(offset int64, whence int) (int64, error) {
 	}
 }
 
-// OpenFile writes to an existing file or creates one
-func (v *Target) OpenFile(path string, perm os.FileMode) (*File, error) {
+// OpenFile opens the file at path. flag is made of the os.O_* flags: with
+// O_CREATE the file is created with perm when it does not exist, and adding
+// O_EXCL makes OpenFile fail when it does, as CreateExclusive. O_TRUNC
+// truncates an existing file. Other flags are ignored.
+func (v *Target) OpenFile(path string, flag int, perm os.FileMode) (*File, error) {
+	return v.OpenFileContext(context.Background(), path, flag, perm)
+}
+
+// OpenFileContext is like OpenFile, but gives up once ctx is done.
+func (v *Target) OpenFileContext(ctx context.Context, path string, flag int, perm os.FileMode) (*File, error) {
+	var f *File
+	err := v.retryStale(func() (err error) {
+		f, err = v.openFile(ctx, path, flag, perm)
+		return err
+	}, filepath.Dir(path))
+
+	return f, err
+}
+
+func (v *Target) openFile(ctx context.Context, path string, flag int, perm os.FileMode) (*File, error) {
 	log.Debugf("open file %s", path)
-	_, fh, err := v.Lookup(path)
+
+	var (
+		fh      []byte
+		err     error
+		created bool
+	)
+	switch {
+	case flag&os.O_CREATE != 0 && flag&os.O_EXCL != 0:
+		fh, err = v.CreateExclusiveContext(ctx, path, perm)
+		created = err == nil
+
+	case flag&os.O_CREATE != 0:
+		fh, err = v.lookupOpen(ctx, path)
+		if !os.IsNotExist(err) {
+			break
+		}
+
+		log.Debugf("%s not exists, create it", path)
+		fh, err = v.createGuarded(ctx, path, perm)
+		created = err == nil
+		if os.IsExist(err) {
+			// someone else created it in the meantime
+			fh, err = v.lookupOpen(ctx, path)
+		}
+
+	default:
+		fh, err = v.lookupOpen(ctx, path)
+	}
 	if err != nil {
-		// log.Warnf("open file %s fail %v", path, err)
-		if os.IsNotExist(err) {
-			log.Warnf("%s not exists, create it", path)
-			fh, err = v.Create(path, perm)
-			if err != nil {
-				log.Errorf("%v", err)
-				return nil, err
-			}
-		} else {
+		return nil, err
+	}
+
+	if flag&os.O_TRUNC != 0 && !created {
+		err = v.setattr(fh, path, Sattr3{Size: SetSize{SetIt: true}}, Sattrguard3{})
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -271,9 +496,36 @@ func (v *Target) OpenFile(path string, perm os.FileMode) (*File, error) {
 	return f, nil
 }
 
+// lookupOpen looks path up to open it. A cached handle is confirmed with the
+// server first, refreshing the attributes as opening a file does, since a
+// File could not recover from a handle gone stale.
+func (v *Target) lookupOpen(ctx context.Context, path string) ([]byte, error) {
+	if fh, ok := v.cachedFH(cachePath(path)); ok {
+		_, err := v.getattrNoCache(ctx, fh, path)
+		if !IsStaleError(err) {
+			return fh, err
+		}
+		v.evict(path)
+	}
+
+	_, fh, err := v.lookup2(ctx, path)
+	return fh, err
+}
+
+// createGuarded creates the file at path, failing if it exists.
+func (v *Target) createGuarded(ctx context.Context, path string, perm os.FileMode) ([]byte, error) {
+	dir, newFile := filepath.Split(path)
+	_, dirfh, err := v.lookup2(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.create(ctx, path, dirfh, newFile, createHow(internal.GUARDED, perm))
+}
+
 // Open opens a file for reading
 func (v *Target) Open(path string) (*File, error) {
-	_, fh, err := v.Lookup(path)
+	fh, err := v.lookupOpen(context.Background(), path)
 	if err != nil {
 		return nil, err
 	}
@@ -289,68 +541,52 @@ func (v *Target) Open(path string) (*File, error) {
 
 // Symlink creates a symlink as where pointing to symlink
 func (v *Target) Symlink(where, symlink string) (*File, error) {
-	type symlinkdata3 struct {
-		SymlinkAttr Sattr3
-		SymlinkData []byte
-	}
-
-	type SymlinkArgs struct {
-		rpc.Header
-		Where   Diropargs3
-		Symlink symlinkdata3
-	}
+	var f *File
+	err := v.retryStale(func() (err error) {
+		f, err = v.symlink(where, symlink)
+		return err
+	}, filepath.Dir(where))
 
-	type SymlinkRes struct {
-		obj     PostOpFH3
-		objAttr PostOpAttr
-		Wcc     WccData
-	}
+	return f, err
+}
 
+func (v *Target) symlink(where, symlink string) (*File, error) {
 	symlinkName := filepath.Base(where)
 	symlinkDir := filepath.Dir(where)
 
-	_, fh, err := v.Lookup(symlinkDir)
+	_, fh, err := v.lookup2(context.Background(), symlinkDir)
 	if err != nil {
 		return nil, err
 	}
 
-	r, err := v.call(&SymlinkArgs{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Symlink,
-			Cred:    v.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		Where: Diropargs3{
-			FH:       fh,
-			Filename: symlinkName,
+	proc := &internal.XdrProc_NFSPROC3_SYMLINK{Arg: &internal.SYMLINK3args{
+		Where: diropargs(fh, symlinkName),
+		Symlink: internal.Symlinkdata3{
+			Symlink_data: symlink,
 		},
-		Symlink: symlinkdata3{
-			SymlinkAttr: Sattr3{},
-			SymlinkData: []byte(symlink),
-		},
-	})
+	}}
 
+	err = v.callProc(context.Background(), proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
 		log.Debugf("Symlink(%s): %s", where, err.Error())
 		return nil, err
 	}
 
-	symlinkres := &SymlinkRes{}
-	if err = xdr.Read(r, symlinkres); err != nil {
-		return nil, err
-	}
-
-	if !symlinkres.obj.IsSet {
+	res := proc.Res.Resok()
+	v.dirWcc(fh, &res.Dir_wcc)
+	obj := postOpFHFrom(&res.Obj)
+	if !obj.IsSet {
 		return nil, errors.New("fh not set")
 	}
+	v.attrs.postOp(obj.FH, &res.Obj_attributes)
 
 	symFile := &File{
 		Target: v,
 		fsinfo: v.fsinfo,
-		fh:     symlinkres.obj.FH,
+		fh:     obj.FH,
 	}
 
 	return symFile, nil
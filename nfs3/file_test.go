@@ -0,0 +1,123 @@
+package nfs3
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+// rebootingServer keeps one file, losing what was written to it UNSTABLE
+// whenever it reboots, which it does before the writes numbered in rebootAt.
+type rebootingServer struct {
+	mu       sync.Mutex
+	verf     byte
+	writes   int
+	rebootAt map[int]bool
+	data     []byte
+	stable   []byte
+}
+
+func (s *rebootingServer) write(t *testing.T, args io.Reader) interface{} {
+	var a internal.WRITE3args
+	decodeArgs(t, args, &a)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.writes++
+	if s.rebootAt[s.writes] {
+		s.verf++
+		s.data = append([]byte(nil), s.stable...)
+	}
+
+	if end := int(a.Offset) + len(a.Data); end > len(s.data) {
+		s.data = append(s.data, make([]byte, end-len(s.data))...)
+	}
+	copy(s.data[a.Offset:], a.Data)
+
+	res := &internal.WRITE3res{}
+	res.Resok().Count = uint32(len(a.Data))
+	res.Resok().Committed = internal.UNSTABLE
+	res.Resok().Verf[0] = s.verf
+	return reply(res)
+}
+
+func (s *rebootingServer) commit(t *testing.T, args io.Reader) interface{} {
+	var a internal.COMMIT3args
+	decodeArgs(t, args, &a)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stable = append([]byte(nil), s.data...)
+
+	res := &internal.COMMIT3res{}
+	res.Resok().Verf[0] = s.verf
+	return reply(res)
+}
+
+func TestWriteResendsAcrossReboots(t *testing.T) {
+	// The second write meets a rebooted server, and the server reboots
+	// again while the data is being sent once more.
+	srv := &rebootingServer{rebootAt: map[int]bool{2: true, 3: true}}
+
+	s := rpc.NewServer()
+	s.Register(Nfs3Prog, Nfs3Vers, NFSProc3Write, func(call *rpc.Call, args io.Reader) (interface{}, error) {
+		return srv.write(t, args), nil
+	})
+	s.Register(Nfs3Prog, Nfs3Vers, NFSProc3Commit, func(call *rpc.Call, args io.Reader) (interface{}, error) {
+		return srv.commit(t, args), nil
+	})
+
+	v := newTestTarget(t, s)
+	f := &File{Target: v, fsinfo: v.fsinfo, fh: []byte("file")}
+
+	for _, chunk := range []string{"first,", "second"} {
+		if _, err := f.Write([]byte(chunk)); err != nil {
+			t.Fatalf("write %q: %s", chunk, err)
+		}
+	}
+	if err := f.Sync(); err != nil {
+		t.Fatalf("sync: %s", err)
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if want := []byte("first,second"); !bytes.Equal(srv.stable, want) {
+		t.Errorf("server holds %q, want %q", srv.stable, want)
+	}
+	if srv.verf != 2 {
+		t.Errorf("server rebooted %d times, want 2", srv.verf)
+	}
+}
+
+func TestWriteFailsWhenNothingIsWritten(t *testing.T) {
+	// The server accepts a few bytes, then no more.
+	s := newNFSServer()
+	s.handle(NFSProc3Write, func(args io.Reader) internal.XdrType {
+		var a internal.WRITE3args
+		decodeArgs(t, args, &a)
+
+		res := &internal.WRITE3res{}
+		if a.Offset == 0 {
+			res.Resok().Count = 3
+		}
+		res.Resok().Committed = internal.FILE_SYNC
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	f := &File{Target: v, fsinfo: v.fsinfo, fh: []byte("file")}
+
+	if n, err := f.Write([]byte("full disk")); n != 3 || err != io.ErrShortWrite {
+		t.Errorf("write: got %d, %v, want 3, %v", n, err, io.ErrShortWrite)
+	}
+	if n := s.count(NFSProc3Write); n != 2 {
+		t.Errorf("%d WRITE, want 2", n)
+	}
+}
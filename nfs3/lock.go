@@ -0,0 +1,755 @@
+package nfs3
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aobco/log"
+	"github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+const (
+	NLMProg = 100021
+	NLMVers = 4
+
+	NLMProc4Test    = 1
+	NLMProc4Lock    = 2
+	NLMProc4Cancel  = 3
+	NLMProc4Unlock  = 4
+	NLMProc4Granted = 5
+	// NLMProc4SMNotify is the procedure lock managers register with NSM to
+	// be told of a monitored host rebooting.
+	NLMProc4SMNotify = 16
+
+	NSMProg = 100024
+	NSMVers = 1
+
+	NSMProcMon   = 2
+	NSMProcUnmon = 3
+)
+
+var (
+	// LockPollInterval is how often a blocked Lock asks the server again, in
+	// case its GRANTED callback cannot reach this client. It also paces
+	// retries while the server is in its grace period.
+	LockPollInterval = 30 * time.Second
+
+	// lastCallbackProg is the last transient RPC program handed to a
+	// LockManager for the local NSM to call back. Each gets its own, so that
+	// none clash with a kernel lock manager or with each other.
+	lastCallbackProg = 0x40000000 + uint32(os.Getpid()&0xffff)<<8
+)
+
+// LockError is a failure reported by the server's lock manager.
+type LockError struct {
+	Stat uint32
+	Name string
+}
+
+func (e *LockError) Error() string { return "nlm: " + e.Name }
+
+func nlmError(stat internal.Nlm4_stats) error {
+	return &LockError{Stat: uint32(stat), Name: stat.String()}
+}
+
+// IsGracePeriodError reports whether the lock manager refused a lock because
+// it is in its grace period after a reboot, when only reclaims are taken. The
+// lock may be asked for again once the grace period is over.
+func IsGracePeriodError(err error) bool {
+	var lockErr *LockError
+	return errors.As(err, &lockErr) && lockErr.Stat == uint32(internal.NLM4_DENIED_GRACE_PERIOD)
+}
+
+// LockHolder describes a conflicting lock reported by TestLock.
+type LockHolder struct {
+	Exclusive bool
+	Svid      int32
+	Owner     []byte
+	Offset    uint64
+	Length    uint64
+}
+
+// LockManager is a client of the NLM v4 lock manager of an NFS server. It
+// serves the GRANTED callbacks of blocked locks, and asks the local NSM
+// (statd) to monitor the server, so that its locks are reclaimed after the
+// server reboots. Without a local NSM locks still work, but are lost on a
+// server reboot. GRANTED callbacks are only believed from the server's own
+// address, and reboot notifications only from the local host.
+type LockManager struct {
+	client   *rpc.Client
+	auth     rpc.Auth
+	server   string
+	hostname string
+
+	prog       uint32
+	listeners  []net.Listener
+	registered []rpc.Mapping
+	monitored  bool
+
+	// state is the NSM state of this host, sent with every LOCK.
+	state  int32
+	cookie uint64
+	svid   int32
+
+	mu      sync.Mutex
+	held    []heldLock
+	waiting map[lockKey]chan struct{}
+}
+
+type lockKey struct {
+	fh     string
+	svid   int32
+	offset uint64
+	length uint64
+}
+
+type heldLock struct {
+	lockKey
+	exclusive bool
+}
+
+// end returns the first byte past the range, 0 meaning the end of the file.
+func (k lockKey) end() uint64 {
+	if k.length == 0 || k.offset+k.length < k.offset {
+		return 0
+	}
+	return k.offset + k.length
+}
+
+// DialLockManager connects to the lock manager on addr, found through the
+// portmapper, and calls it with auth. opts may be nil.
+func DialLockManager(ctx context.Context, addr string, auth rpc.Auth, opts *DialOptions) (*LockManager, error) {
+	client, err := DialServiceOptions(ctx, addr, rpc.Mapping{
+		Prog: NLMProg,
+		Vers: NLMVers,
+		Prot: rpc.IPProtoTCP,
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLockManager(client, auth, addr), nil
+}
+
+// NewLockManager returns a LockManager that calls the lock manager of server
+// over client.
+func NewLockManager(client *rpc.Client, auth rpc.Auth, server string) *LockManager {
+	m := &LockManager{
+		client:  client,
+		auth:    auth,
+		server:  server,
+		prog:    atomic.AddUint32(&lastCallbackProg, 1),
+		waiting: make(map[lockKey]chan struct{}),
+	}
+
+	if m.hostname, _ = os.Hostname(); m.hostname == "" {
+		m.hostname = "localhost"
+	}
+
+	if err := m.serveCallbacks(); err != nil {
+		log.Warnf("nlm: not serving callbacks, blocked locks will be polled: %s", err)
+	} else if err := m.monitor(); err != nil {
+		log.Warnf("nlm: cannot monitor %s, locks will not be reclaimed after it reboots: %s", server, err)
+	}
+
+	return m
+}
+
+// serveCallbacks listens for GRANTED callbacks and NSM notifications on the
+// transient program m.prog, and registers it with the local portmapper. The
+// listener is bound to the address the lock manager is reached from; the
+// local NSM calls back over the loopback, so it gets a second listener on
+// the same port there. NLM itself is left to the host's own lock manager.
+func (m *LockManager) serveCallbacks() error {
+	ip := hostIP(m.client.LocalAddr())
+	if ip == nil {
+		ip = net.IPv4(127, 0, 0, 1)
+	}
+
+	l, err := net.Listen("tcp", net.JoinHostPort(ip.String(), "0"))
+	if err != nil {
+		return err
+	}
+	m.listeners = append(m.listeners, l)
+	port := l.Addr().(*net.TCPAddr).Port
+
+	s := rpc.NewServer()
+	s.Authenticate = m.authenticate
+	s.Register(m.prog, NLMVers, NLMProc4Granted, m.granted)
+	s.Register(m.prog, NLMVers, NLMProc4SMNotify, m.notified)
+	go s.Serve(l)
+
+	if !ip.IsLoopback() {
+		lo, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		if err != nil {
+			log.Warnf("nlm: cannot listen for reboot notifications: %s", err)
+		} else {
+			m.listeners = append(m.listeners, lo)
+			go s.Serve(lo)
+		}
+	}
+
+	pm, err := rpc.DialPortmapper("tcp", "127.0.0.1")
+	if err != nil {
+		return err
+	}
+	defer pm.Close()
+
+	mapping := rpc.Mapping{Prog: m.prog, Vers: NLMVers, Prot: rpc.IPProtoTCP, Port: uint32(port)}
+	ok, err := pm.Set(mapping)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("program %d already registered", m.prog)
+	}
+	m.registered = append(m.registered, mapping)
+
+	return nil
+}
+
+// authenticate admits GRANTED callbacks from the lock manager's host, and
+// reboot notifications from the local NSM. Anyone else could otherwise hand a
+// waiting Lock a lock the server never granted.
+func (m *LockManager) authenticate(call *rpc.Call) uint32 {
+	var ok bool
+	switch call.Proc {
+	case NLMProc4Granted:
+		ok = sameHost(call.RemoteAddr, m.client.RemoteAddr())
+	case NLMProc4SMNotify:
+		ip := hostIP(call.RemoteAddr)
+		ok = ip != nil && ip.IsLoopback()
+	default:
+		ok = true
+	}
+
+	if !ok {
+		log.Warnf("nlm: refusing procedure %d from %s", call.Proc, call.RemoteAddr)
+		return rpc.AuthRejectedCred
+	}
+
+	return rpc.AuthOk
+}
+
+func sameHost(a, b net.Addr) bool {
+	ip := hostIP(a)
+	return ip != nil && ip.Equal(hostIP(b))
+}
+
+func hostIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		return a.IP
+	case *net.UDPAddr:
+		return a.IP
+	}
+
+	return nil
+}
+
+// monitor asks the local NSM to call back when the server reboots.
+func (m *LockManager) monitor() error {
+	nsm, err := DialService("127.0.0.1", rpc.Mapping{Prog: NSMProg, Vers: NSMVers, Prot: rpc.IPProtoTCP})
+	if err != nil {
+		return err
+	}
+	defer nsm.Close()
+
+	proc := &internal.XdrProc_SM_MON{Arg: &internal.Mon{
+		Mon_id: m.monID(),
+	}}
+	if err = callProc(context.Background(), nsm, rpc.AuthNull, proc); err != nil {
+		return err
+	}
+
+	if proc.Res.Res_stat != internal.Stat_succ {
+		return errors.New("nsm refused to monitor")
+	}
+
+	m.state = proc.Res.State
+	m.monitored = true
+	return nil
+}
+
+func (m *LockManager) monID() internal.Mon_id {
+	return internal.Mon_id{
+		Mon_name: m.server,
+		My_id: internal.My_id{
+			My_name: "127.0.0.1",
+			My_prog: int32(m.prog),
+			My_vers: NLMVers,
+			My_proc: NLMProc4SMNotify,
+		},
+	}
+}
+
+// Close releases the locks still held, stops monitoring the server and
+// serving callbacks, and closes the connection to the lock manager.
+func (m *LockManager) Close() error {
+	m.mu.Lock()
+	held := append([]heldLock(nil), m.held...)
+	m.mu.Unlock()
+
+	for _, l := range held {
+		ctx, cancel := context.WithTimeout(context.Background(), LockPollInterval)
+		if err := m.unlock(ctx, l.lockKey); err != nil {
+			log.Warnf("nlm: releasing lock on %x: %s", l.fh, err)
+		}
+		cancel()
+	}
+
+	if m.monitored {
+		if nsm, err := DialService("127.0.0.1", rpc.Mapping{Prog: NSMProg, Vers: NSMVers, Prot: rpc.IPProtoTCP}); err == nil {
+			id := m.monID()
+			callProc(context.Background(), nsm, rpc.AuthNull, &internal.XdrProc_SM_UNMON{Arg: &id})
+			nsm.Close()
+		}
+	}
+
+	if len(m.registered) > 0 {
+		if pm, err := rpc.DialPortmapper("tcp", "127.0.0.1"); err == nil {
+			for _, mapping := range m.registered {
+				pm.Unset(mapping)
+			}
+			pm.Close()
+		}
+	}
+
+	for _, l := range m.listeners {
+		l.Close()
+	}
+
+	return m.client.Close()
+}
+
+// newOwner returns a lock owner id for a File.
+func (m *LockManager) newOwner() int32 {
+	return atomic.AddInt32(&m.svid, 1)
+}
+
+func (m *LockManager) nlmLock(fh []byte, svid int32, offset, length uint64) internal.Nlm4_lock {
+	return internal.Nlm4_lock{
+		Caller_name: m.hostname,
+		Fh:          fh,
+		Oh:          []byte(strconv.Itoa(int(svid)) + "@" + m.hostname),
+		Svid:        svid,
+		L_offset:    offset,
+		L_len:       length,
+	}
+}
+
+func (m *LockManager) nextCookie() []byte {
+	cookie := make([]byte, 8)
+	binary.BigEndian.PutUint64(cookie, atomic.AddUint64(&m.cookie, 1))
+	return cookie
+}
+
+// lock takes a lock, waiting for it to be granted when wait is set. Without
+// wait it never blocks: it returns false when the lock is held by someone
+// else, and fails during the server's grace period.
+func (m *LockManager) lock(ctx context.Context, fh []byte, svid int32, exclusive bool, offset, length uint64, wait bool) (bool, error) {
+	key := lockKey{fh: string(fh), svid: svid, offset: offset, length: length}
+
+	for {
+		var granted chan struct{}
+		if wait {
+			granted = m.expect(key)
+		}
+
+		stat, err := m.sendLock(ctx, key, exclusive, wait, false)
+		if err != nil {
+			m.unexpect(key)
+			return false, err
+		}
+
+		switch stat {
+		case internal.NLM4_GRANTED:
+			m.unexpect(key)
+			m.hold(heldLock{key, exclusive})
+			return true, nil
+
+		case internal.NLM4_DENIED, internal.NLM4_BLOCKED:
+			if !wait {
+				return false, nil
+			}
+
+		case internal.NLM4_DENIED_GRACE_PERIOD:
+			if !wait {
+				return false, nlmError(stat)
+			}
+
+		default:
+			m.unexpect(key)
+			return false, nlmError(stat)
+		}
+
+		// Wait for the server to call back, asking again now and then in
+		// case its callback cannot reach us.
+		select {
+		case <-granted:
+			m.hold(heldLock{key, exclusive})
+			return true, nil
+
+		case <-time.After(LockPollInterval):
+			m.unexpect(key)
+
+		case <-ctx.Done():
+			m.unexpect(key)
+			m.cancel(key, exclusive, granted)
+			return false, ctx.Err()
+		}
+	}
+}
+
+func (m *LockManager) sendLock(ctx context.Context, key lockKey, exclusive, block, reclaim bool) (internal.Nlm4_stats, error) {
+	proc := &internal.XdrProc_NLMPROC4_LOCK{Arg: &internal.Nlm4_lockargs{
+		Cookie:    m.nextCookie(),
+		Block:     block,
+		Exclusive: exclusive,
+		Alock:     m.nlmLock([]byte(key.fh), key.svid, key.offset, key.length),
+		Reclaim:   reclaim,
+		State:     m.state,
+	}}
+
+	if err := callProc(ctx, m.client, m.auth, proc); err != nil {
+		return 0, err
+	}
+
+	return proc.Res.Stat.Stat, nil
+}
+
+// cancel withdraws a blocked lock request. Should the lock have been granted
+// in the meantime, it is released.
+func (m *LockManager) cancel(key lockKey, exclusive bool, granted chan struct{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), LockPollInterval)
+	defer cancel()
+
+	proc := &internal.XdrProc_NLMPROC4_CANCEL{Arg: &internal.Nlm4_cancargs{
+		Cookie:    m.nextCookie(),
+		Block:     true,
+		Exclusive: exclusive,
+		Alock:     m.nlmLock([]byte(key.fh), key.svid, key.offset, key.length),
+	}}
+	if err := callProc(ctx, m.client, m.auth, proc); err != nil {
+		log.Debugf("nlm: cancel: %s", err)
+	}
+
+	select {
+	case <-granted:
+		if err := m.unlock(ctx, key); err != nil {
+			log.Warnf("nlm: releasing lock granted after cancel: %s", err)
+		}
+	default:
+	}
+}
+
+func (m *LockManager) unlock(ctx context.Context, key lockKey) error {
+	proc := &internal.XdrProc_NLMPROC4_UNLOCK{Arg: &internal.Nlm4_unlockargs{
+		Cookie: m.nextCookie(),
+		Alock:  m.nlmLock([]byte(key.fh), key.svid, key.offset, key.length),
+	}}
+
+	if err := callProc(ctx, m.client, m.auth, proc); err != nil {
+		return err
+	}
+
+	if stat := proc.Res.Stat.Stat; stat != internal.NLM4_GRANTED {
+		return nlmError(stat)
+	}
+
+	m.release(key)
+	return nil
+}
+
+func (m *LockManager) test(ctx context.Context, fh []byte, svid int32, exclusive bool, offset, length uint64) (*LockHolder, error) {
+	proc := &internal.XdrProc_NLMPROC4_TEST{Arg: &internal.Nlm4_testargs{
+		Cookie:    m.nextCookie(),
+		Exclusive: exclusive,
+		Alock:     m.nlmLock(fh, svid, offset, length),
+	}}
+
+	if err := callProc(ctx, m.client, m.auth, proc); err != nil {
+		return nil, err
+	}
+
+	switch res := &proc.Res.Test_stat; res.Stat {
+	case internal.NLM4_GRANTED:
+		return nil, nil
+	case internal.NLM4_DENIED:
+		h := res.Holder()
+		return &LockHolder{
+			Exclusive: h.Exclusive,
+			Svid:      h.Svid,
+			Owner:     h.Oh,
+			Offset:    h.L_offset,
+			Length:    h.L_len,
+		}, nil
+	default:
+		return nil, nlmError(res.Stat)
+	}
+}
+
+// expect returns a channel closed when the server grants the lock key.
+func (m *LockManager) expect(key lockKey) chan struct{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ch := make(chan struct{})
+	m.waiting[key] = ch
+	return ch
+}
+
+func (m *LockManager) unexpect(key lockKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.waiting, key)
+}
+
+func (m *LockManager) hold(l heldLock) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.held = append(m.held, l)
+}
+
+// release forgets the held locks within key's range, keeping the parts of
+// them outside it.
+func (m *LockManager) release(key lockKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var held []heldLock
+	for _, l := range m.held {
+		if l.fh != key.fh || l.svid != key.svid || !overlaps(l.lockKey, key) {
+			held = append(held, l)
+			continue
+		}
+
+		if l.offset < key.offset {
+			before := l
+			before.length = key.offset - l.offset
+			held = append(held, before)
+		}
+		if end := key.end(); end != 0 && (l.end() == 0 || l.end() > end) {
+			after := l
+			after.offset = end
+			if l.end() != 0 {
+				after.length = l.end() - end
+			}
+			held = append(held, after)
+		}
+	}
+	m.held = held
+}
+
+func overlaps(a, b lockKey) bool {
+	return (a.end() == 0 || a.end() > b.offset) && (b.end() == 0 || b.end() > a.offset)
+}
+
+// granted serves the server's GRANTED callback for a blocked lock.
+func (m *LockManager) granted(call *rpc.Call, args io.Reader) (interface{}, error) {
+	var a internal.Nlm4_testargs
+	if err := xdrMarshal(internal.XdrIn{In: args}, &a); err != nil {
+		return nil, rpc.ErrGarbageArgs
+	}
+
+	key := lockKey{fh: string(a.Alock.Fh), svid: a.Alock.Svid, offset: a.Alock.L_offset, length: a.Alock.L_len}
+
+	m.mu.Lock()
+	ch, ok := m.waiting[key]
+	delete(m.waiting, key)
+	m.mu.Unlock()
+
+	// Refusing a lock nobody waits for any more makes the server release it.
+	stat := internal.NLM4_DENIED
+	if ok {
+		close(ch)
+		stat = internal.NLM4_GRANTED
+	}
+
+	return &internal.Nlm4_res{Cookie: a.Cookie, Stat: internal.Nlm4_stat{Stat: stat}}, nil
+}
+
+// notified serves the local NSM's callback for a reboot of the server.
+func (m *LockManager) notified(call *rpc.Call, args io.Reader) (interface{}, error) {
+	var a internal.Nlm4_sm_status
+	if err := xdrMarshal(internal.XdrIn{In: args}, &a); err != nil {
+		return nil, rpc.ErrGarbageArgs
+	}
+
+	log.Infof("nlm: %s rebooted (state %d), reclaiming locks", a.Mon_name, a.State)
+	go m.reclaim()
+
+	return nil, nil
+}
+
+// reclaim takes the held locks again during the server's grace period.
+func (m *LockManager) reclaim() {
+	m.mu.Lock()
+	held := append([]heldLock(nil), m.held...)
+	m.mu.Unlock()
+
+	for _, l := range held {
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), LockPollInterval)
+			stat, err := m.sendLock(ctx, l.lockKey, l.exclusive, false, true)
+			cancel()
+
+			if err == nil && stat == internal.NLM4_DENIED_GRACE_PERIOD {
+				time.Sleep(time.Second)
+				continue
+			}
+			if err == nil && stat != internal.NLM4_GRANTED {
+				err = nlmError(stat)
+			}
+			if err != nil {
+				log.Errorf("nlm: reclaiming lock on %x: %s", l.fh, err)
+				m.release(l.lockKey)
+			}
+			break
+		}
+	}
+}
+
+// SetLockManager makes the Target's Files lock through m. It is needed for
+// Targets made from clients; others dial the server's lock manager when a
+// File is first locked.
+func (v *Target) SetLockManager(m *LockManager) {
+	v.lockMu.Lock()
+	defer v.lockMu.Unlock()
+
+	v.locks = m
+}
+
+func (v *Target) lockManager(ctx context.Context) (*LockManager, error) {
+	v.lockMu.Lock()
+	defer v.lockMu.Unlock()
+
+	if v.locks != nil {
+		return v.locks, nil
+	}
+
+	if v.addr == "" {
+		return nil, errors.New("nlm: target has no server address, use SetLockManager")
+	}
+
+	// The lock manager is a separate service: it does not speak TLS, and the
+	// ports fixed for NFS and MOUNT are not its own.
+	opts := v.opts
+	opts.TLSConfig, opts.NFSPort, opts.MountPort = nil, 0, 0
+
+	m, err := DialLockManager(ctx, v.addr, v.Auth, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	v.locks = m
+	return m, nil
+}
+
+// lockOwner returns the lock manager and the File's lock owner.
+func (f *File) lockOwner(ctx context.Context) (*LockManager, int32, error) {
+	m, err := f.lockManager(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if f.svid == 0 {
+		f.svid = m.newOwner()
+	}
+
+	return m, f.svid, nil
+}
+
+// Lock takes a POSIX-style byte-range lock on the file, waiting until it is
+// granted. length 0 locks to the end of the file, however far it grows. Each
+// File is its own lock owner, so two Files opened on the same path conflict.
+func (f *File) Lock(exclusive bool, offset, length uint64) error {
+	return f.LockContext(context.Background(), exclusive, offset, length)
+}
+
+// LockContext is like Lock, but gives up waiting once ctx is done.
+func (f *File) LockContext(ctx context.Context, exclusive bool, offset, length uint64) error {
+	m, svid, err := f.lockOwner(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.lock(ctx, f.fh, svid, exclusive, offset, length, true)
+	return err
+}
+
+// TryLock is like Lock, but returns false instead of waiting when the range
+// is locked by someone else. While the server is in its grace period after a
+// reboot it fails with an error for which IsGracePeriodError is true.
+func (f *File) TryLock(exclusive bool, offset, length uint64) (bool, error) {
+	return f.TryLockContext(context.Background(), exclusive, offset, length)
+}
+
+// TryLockContext is like TryLock, but gives up once ctx is done.
+func (f *File) TryLockContext(ctx context.Context, exclusive bool, offset, length uint64) (bool, error) {
+	m, svid, err := f.lockOwner(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return m.lock(ctx, f.fh, svid, exclusive, offset, length, false)
+}
+
+// Unlock releases the locks the File holds within the range.
+func (f *File) Unlock(offset, length uint64) error {
+	return f.UnlockContext(context.Background(), offset, length)
+}
+
+// UnlockContext is like Unlock, but gives up once ctx is done.
+func (f *File) UnlockContext(ctx context.Context, offset, length uint64) error {
+	m, svid, err := f.lockOwner(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.unlock(ctx, lockKey{fh: string(f.fh), svid: svid, offset: offset, length: length})
+}
+
+// unlockAll releases every lock the File holds, as closing a file does.
+func (f *File) unlockAll() error {
+	if f.svid == 0 {
+		return nil
+	}
+
+	f.lockMu.Lock()
+	m := f.locks
+	f.lockMu.Unlock()
+	if m == nil {
+		return nil
+	}
+
+	return m.unlock(context.Background(), lockKey{fh: string(f.fh), svid: f.svid})
+}
+
+// TestLock reports a lock that would conflict with locking the range, or nil
+// if it could be locked.
+func (f *File) TestLock(exclusive bool, offset, length uint64) (*LockHolder, error) {
+	return f.TestLockContext(context.Background(), exclusive, offset, length)
+}
+
+// TestLockContext is like TestLock, but gives up once ctx is done.
+func (f *File) TestLockContext(ctx context.Context, exclusive bool, offset, length uint64) (*LockHolder, error) {
+	m, svid, err := f.lockOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.test(ctx, f.fh, svid, exclusive, offset, length)
+}
@@ -0,0 +1,157 @@
+package nfs3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+// newTestLockManager returns a LockManager calling the NLM procedures
+// registered on s, without serving callbacks or monitoring the server.
+func newTestLockManager(t *testing.T, s *rpc.Server) *LockManager {
+	v := newTestTarget(t, s)
+	return &LockManager{
+		client:   v.Client,
+		auth:     rpc.AuthNull,
+		hostname: "client",
+		waiting:  make(map[lockKey]chan struct{}),
+	}
+}
+
+func TestTryLockDoesNotWait(t *testing.T) {
+	for _, tt := range []struct {
+		stat  internal.Nlm4_stats
+		ok    bool
+		grace bool
+	}{
+		{stat: internal.NLM4_GRANTED, ok: true},
+		{stat: internal.NLM4_DENIED},
+		{stat: internal.NLM4_BLOCKED},
+		{stat: internal.NLM4_DENIED_GRACE_PERIOD, grace: true},
+	} {
+		tt := tt
+		s := rpc.NewServer()
+		s.Register(NLMProg, NLMVers, NLMProc4Lock, func(call *rpc.Call, args io.Reader) (interface{}, error) {
+			var a internal.Nlm4_lockargs
+			decodeArgs(t, args, &a)
+			if a.Block {
+				t.Errorf("%s: TryLock asked to block", tt.stat)
+			}
+			return reply(&internal.Nlm4_res{Cookie: a.Cookie, Stat: internal.Nlm4_stat{Stat: tt.stat}}), nil
+		})
+		m := newTestLockManager(t, s)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			ok, err := m.lock(context.Background(), []byte("file"), 1, true, 0, 0, false)
+			if ok != tt.ok || IsGracePeriodError(err) != tt.grace || (err != nil && !tt.grace) {
+				t.Errorf("%s: got %v, %v", tt.stat, ok, err)
+			}
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: TryLock waited", tt.stat)
+		}
+	}
+}
+
+func TestCallbackAuthentication(t *testing.T) {
+	m := newTestLockManager(t, rpc.NewServer())
+	server := m.client.RemoteAddr().(*net.TCPAddr).IP
+
+	for _, tt := range []struct {
+		proc uint32
+		from net.IP
+		ok   bool
+	}{
+		{proc: NLMProc4Granted, from: server, ok: true},
+		{proc: NLMProc4Granted, from: net.ParseIP("192.0.2.1")},
+		{proc: NLMProc4SMNotify, from: net.ParseIP("::1"), ok: true},
+		{proc: NLMProc4SMNotify, from: net.ParseIP("192.0.2.1")},
+		{proc: 0, from: net.ParseIP("192.0.2.1"), ok: true},
+	} {
+		call := &rpc.Call{RemoteAddr: &net.TCPAddr{IP: tt.from, Port: 1000}}
+		call.Proc = tt.proc
+
+		if stat := m.authenticate(call); (stat == rpc.AuthOk) != tt.ok {
+			t.Errorf("procedure %d from %s: auth_stat %d", tt.proc, tt.from, stat)
+		}
+	}
+}
+
+func TestCallbacksListenOnLockManagerAddress(t *testing.T) {
+	m := newTestLockManager(t, rpc.NewServer())
+	m.prog = atomic.AddUint32(&lastCallbackProg, 1)
+
+	// Without a local portmapper the callbacks are served but not
+	// registered.
+	if err := m.serveCallbacks(); err != nil {
+		t.Logf("serving callbacks: %s", err)
+	}
+	t.Cleanup(func() { m.Close() })
+
+	if len(m.listeners) == 0 {
+		t.Fatal("not listening for callbacks")
+	}
+	local := m.client.LocalAddr()
+	for _, l := range m.listeners {
+		if !sameHost(l.Addr(), local) {
+			t.Errorf("listening on %s, want the address of %s", l.Addr(), local)
+		}
+	}
+	for _, mapping := range m.registered {
+		if mapping.Prog != m.prog {
+			t.Errorf("registered program %d, want only %d", mapping.Prog, m.prog)
+		}
+	}
+}
+
+func TestLockCallsHonorContext(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	s := rpc.NewServer()
+	for _, proc := range []uint32{NLMProc4Test, NLMProc4Lock, NLMProc4Unlock} {
+		s.Register(NLMProg, NLMVers, proc, func(call *rpc.Call, args io.Reader) (interface{}, error) {
+			<-release
+			return nil, rpc.ErrSystemErr
+		})
+	}
+	m := newTestLockManager(t, s)
+	v := newTestTarget(t, rpc.NewServer())
+	v.SetLockManager(m)
+	f := &File{Target: v, fsinfo: v.fsinfo, fh: []byte("file")}
+
+	for name, call := range map[string]func(ctx context.Context) error{
+		"TryLockContext": func(ctx context.Context) error {
+			_, err := f.TryLockContext(ctx, true, 0, 0)
+			return err
+		},
+		"UnlockContext": func(ctx context.Context) error {
+			return f.UnlockContext(ctx, 0, 0)
+		},
+		"TestLockContext": func(ctx context.Context) error {
+			_, err := f.TestLockContext(ctx, true, 0, 0)
+			return err
+		},
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		err := call(ctx)
+		cancel()
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s against a server that does not answer: %v, want a deadline error", name, err)
+		}
+	}
+}
@@ -58,3 +58,40 @@ func (c *LRUCache) Add(key string, value []byte) {
 	newElement := c.lruList.PushFront(&entry{key, value})
 	c.cache[key] = newElement
 }
+
+// Remove removes the entry for key, if any.
+func (c *LRUCache) Remove(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.cache[key]; ok {
+		delete(c.cache, key)
+		c.lruList.Remove(element)
+	}
+}
+
+// RemoveFunc removes every entry for which f returns true. f must not use the
+// cache.
+func (c *LRUCache) RemoveFunc(f func(key string, value []byte) bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key, element := range c.cache {
+		if f(key, element.Value.(*entry).value) {
+			delete(c.cache, key)
+			c.lruList.Remove(element)
+		}
+	}
+}
+
+// Range calls f for every entry, most recently used first. f must not use the
+// cache.
+func (c *LRUCache) Range(f func(key string, value []byte)) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for element := c.lruList.Front(); element != nil; element = element.Next() {
+		e := element.Value.(*entry)
+		f(e.key, e.value)
+	}
+}
@@ -1,11 +1,15 @@
 package nfs3
 
 import (
+	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/aobco/nfs/internal"
 	"github.com/aobco/nfs/nfs3/rpc"
-	"github.com/aobco/nfs/nfs3/xdr"
 )
 
 const (
@@ -14,6 +18,7 @@ const (
 
 	MountProc3Null   = 0
 	MountProc3MNT    = 1
+	MountProc3Dump   = 2
 	MountProc3UMNT   = 3
 	MountProc3Export = 5
 
@@ -34,82 +39,144 @@ type Mount struct {
 	auth    rpc.Auth
 	dirPath string
 	Addr    string
-	Target *Target
+	Target  *Target
+
+	// Nconnect is the number of connections the mounted Target opens to the
+	// NFS service, and Balance how calls are spread across them. Set them
+	// before calling Mount.
+	Nconnect int
+	Balance  Balance
+
+	// TLSConfig, when set, makes the mounted Target use RPC-over-TLS.
+	TLSConfig *tls.Config
+
+	// AuthFlavors are the credential flavors the server offered for the
+	// last directory mounted.
+	AuthFlavors []uint32
+
+	// opts are the options the Mount was dialed with, reused for the Target.
+	opts DialOptions
 }
 
-func (m *Mount) Unmount() error {
-	type umount struct {
-		rpc.Header
-		Dirpath string
+// Export is a directory the server exports, with the groups (hosts,
+// netgroups or networks) allowed to mount it. No groups means anyone may.
+type Export struct {
+	Dir    string
+	Groups []string
+}
+
+// MountEntry is a directory a client has mounted, as the server remembers it.
+type MountEntry struct {
+	Hostname  string
+	Directory string
+}
+
+// Exports returns the server's export list, as showmount -e does.
+func (m *Mount) Exports() ([]Export, error) {
+	return m.ExportsContext(context.Background())
+}
+
+// ExportsContext is like Exports, but gives up once ctx is done.
+func (m *Mount) ExportsContext(ctx context.Context) ([]Export, error) {
+	proc := &internal.XdrProc_MOUNTPROC3_EXPORT{}
+	if err := callProc(ctx, m.Client, rpc.AuthNull, proc); err != nil {
+		return nil, err
 	}
 
-	_, err := m.Call(&umount{
-		rpc.Header{
-			Rpcvers: 2,
-			Prog:    MountProg,
-			Vers:    MountVers,
-			Proc:    MountProc3UMNT,
-			// Weirdly, the spec calls for AUTH_UNIX or better, but AUTH_NULL
-			// works here on a linux NFS kernel server.  Follow the spec
-			// anyway.
-			Cred: m.auth,
-			Verf: rpc.AuthNull,
-		},
-		m.dirPath,
-	})
-	if err != nil {
-		return err
+	var exports []Export
+	for e := *proc.Res; e != nil; e = e.Ex_next {
+		export := Export{Dir: e.Ex_dir}
+		for g := e.Ex_groups; g != nil; g = g.Gr_next {
+			export.Groups = append(export.Groups, g.Gr_name)
+		}
+		exports = append(exports, export)
 	}
 
-	return nil
+	return exports, nil
 }
 
-func (m *Mount) Mount(dirpath string, auth rpc.Auth) (*Target, error) {
-	type mount struct {
-		rpc.Header
-		Dirpath string
-	}
+// Dump returns the mounts the server believes its clients hold, as
+// showmount -a does. Servers only learn of unmounts clients tell them about,
+// so the list may be stale.
+func (m *Mount) Dump() ([]MountEntry, error) {
+	return m.DumpContext(context.Background())
+}
 
-	res, err := m.Call(&mount{
-		rpc.Header{
-			Rpcvers: 2,
-			Prog:    MountProg,
-			Vers:    MountVers,
-			Proc:    MountProc3MNT,
-			Cred:    auth,
-			Verf:    rpc.AuthNull,
-		},
-		dirpath,
-	})
-	if err != nil {
+// DumpContext is like Dump, but gives up once ctx is done.
+func (m *Mount) DumpContext(ctx context.Context) ([]MountEntry, error) {
+	proc := &internal.XdrProc_MOUNTPROC3_DUMP{}
+	if err := callProc(ctx, m.Client, rpc.AuthNull, proc); err != nil {
 		return nil, err
 	}
 
-	mountstat3, err := xdr.ReadUint32(res)
-	if err != nil {
+	var mounts []MountEntry
+	for b := *proc.Res; b != nil; b = b.Ml_next {
+		mounts = append(mounts, MountEntry{Hostname: b.Ml_hostname, Directory: b.Ml_directory})
+	}
+
+	return mounts, nil
+}
+
+func (m *Mount) Unmount() error {
+	if m.Target != nil {
+		m.Target.lockMu.Lock()
+		if m.Target.locks != nil {
+			m.Target.locks.Close()
+			m.Target.locks = nil
+		}
+		m.Target.lockMu.Unlock()
+	}
+
+	dirpath := m.dirPath
+	// Weirdly, the spec calls for AUTH_UNIX or better, but AUTH_NULL works
+	// here on a linux NFS kernel server.  Follow the spec anyway.
+	return callProc(context.Background(), m.Client, m.auth, &internal.XdrProc_MOUNTPROC3_UMNT{Arg: &dirpath})
+}
+
+func (m *Mount) Mount(dirpath string, auth rpc.Auth) (*Target, error) {
+	return m.MountContext(context.Background(), dirpath, auth)
+}
+
+// MountContext is like Mount, but gives up once ctx is done. ctx bounds the
+// MNT call and the FSINFO call made when setting up the Target.
+func (m *Mount) MountContext(ctx context.Context, dirpath string, auth rpc.Auth) (*Target, error) {
+	proc := &internal.XdrProc_MOUNTPROC3_MNT{Arg: &dirpath}
+	if err := callProc(ctx, m.Client, auth, proc); err != nil {
 		return nil, err
 	}
 
+	mountstat3 := proc.Res.Fhs_status
 	switch mountstat3 {
 	case MNT3Ok:
-		fh, err := xdr.ReadOpaque(res)
-		if err != nil {
-			return nil, err
-		}
+		fh := proc.Res.Mountinfo().Fhandle
 
-		_, _ = xdr.ReadUint32List(res)
+		m.AuthFlavors = nil
+		for _, flavor := range proc.Res.Mountinfo().Auth_flavors {
+			m.AuthFlavors = append(m.AuthFlavors, uint32(flavor))
+		}
+		if !flavorAccepted(m.AuthFlavors, auth.Flavor) {
+			// The server has recorded the mount; tell it we are not using it.
+			callProc(ctx, m.Client, auth, &internal.XdrProc_MOUNTPROC3_UMNT{Arg: &dirpath})
+			return nil, fmt.Errorf("mount %s: server accepts auth flavors %s, not %s",
+				dirpath, flavorNames(m.AuthFlavors), flavorName(auth.Flavor))
+		}
 
 		m.dirPath = dirpath
 		m.auth = auth
 
-		var vol *Target
+		var (
+			vol *Target
+			err error
+		)
 		if m.Addr != "" {
-			vol, err = NewTarget(m.Addr, auth, fh, dirpath)
+			opts := m.opts
+			opts.Nconnect, opts.Balance, opts.TLSConfig = m.Nconnect, m.Balance, m.TLSConfig
+			vol, err = newTarget(ctx, m.Addr, &opts, auth, fh, dirpath)
 			if err != nil {
 				return nil, err
 			}
 		} else {
-			vol, err = NewTargetWithClient(m.Client, auth, fh, dirpath)
+			vol, err = newTargetWithClients(ctx, []*rpc.Client{m.Client}, RoundRobin, auth, fh, dirpath)
 			if err != nil {
 				return nil, err
 			}
@@ -133,7 +200,81 @@ func (m *Mount) Mount(dirpath string, auth rpc.Auth) (*Target, error) {
 	return nil, fmt.Errorf("unknown mount stat: %d", mountstat3)
 }
 
+// flavorAccepted reports whether a server offering flavors takes credentials
+// of flavor. Servers that offer none predate the list and take AUTH_UNIX, and
+// offering AUTH_NULL means credentials are ignored, so any flavor will do.
+// AUTH_NULL is also let through when AUTH_UNIX is all that is offered:
+// servers such as Linux nfsd take it without listing it, mapping such calls
+// to the anonymous user. Exports that only offer Kerberos refuse it.
+func flavorAccepted(flavors []uint32, flavor uint32) bool {
+	if len(flavors) == 0 {
+		return flavor == rpc.AuthFlavorUnix || flavor == rpc.AuthFlavorNull
+	}
+
+	unixOnly := true
+	for _, f := range flavors {
+		if f == flavor || f == rpc.AuthFlavorNull {
+			return true
+		}
+		if f != rpc.AuthFlavorUnix {
+			unixOnly = false
+		}
+	}
+
+	return flavor == rpc.AuthFlavorNull && unixOnly
+}
+
+func flavorName(flavor uint32) string {
+	switch flavor {
+	case rpc.AuthFlavorNull:
+		return "AUTH_NULL"
+	case rpc.AuthFlavorUnix:
+		return "AUTH_UNIX"
+	case rpc.AuthFlavorShort:
+		return "AUTH_SHORT"
+	case rpc.AuthFlavorDH:
+		return "AUTH_DH"
+	case rpc.AuthFlavorGSS:
+		return "RPCSEC_GSS"
+	case 390003:
+		return "krb5"
+	case 390004:
+		return "krb5i"
+	case 390005:
+		return "krb5p"
+	}
+
+	return strconv.FormatUint(uint64(flavor), 10)
+}
+
+func flavorNames(flavors []uint32) string {
+	names := make([]string, len(flavors))
+	for i, f := range flavors {
+		names[i] = flavorName(f)
+	}
+
+	return strings.Join(names, ", ")
+}
+
 func DialMount(addr string) (*Mount, error) {
+	return DialMountTLS(addr, nil)
+}
+
+// DialMountTLS is like DialMount, but talks to the MOUNT service over
+// RPC-over-TLS when config is not nil. The Target returned by Mount uses the
+// same config for its NFS connections.
+func DialMountTLS(addr string, config *tls.Config) (*Mount, error) {
+	return DialMountWithOptions(addr, &DialOptions{TLSConfig: config})
+}
+
+// DialMountWithOptions is like DialMount, with opts controlling how the MOUNT
+// service is reached. The Target returned by Mount is dialed with the same
+// options. opts may be nil.
+func DialMountWithOptions(addr string, opts *DialOptions) (*Mount, error) {
+	if opts == nil {
+		opts = &DialOptions{}
+	}
+
 	// get MOUNT port
 	m := rpc.Mapping{
 		Prog: MountProg,
@@ -142,13 +283,26 @@ func DialMount(addr string) (*Mount, error) {
 		Port: 0,
 	}
 
-	client, err := DialService(addr, m)
+	ctx := context.Background()
+	client, err := DialServiceOptions(ctx, addr, m, opts)
+	if err != nil && opts.TLSConfig == nil && opts.Dial == nil {
+		// some older servers only register MOUNT over UDP
+		m.Prot = rpc.IPProtoUDP
+		var uerr error
+		if client, uerr = DialServiceOptions(ctx, addr, m, opts); uerr == nil {
+			err = nil
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &Mount{
-		Client: client,
-		Addr:   addr,
+		Client:    client,
+		Addr:      addr,
+		Nconnect:  opts.Nconnect,
+		Balance:   opts.Balance,
+		TLSConfig: opts.TLSConfig,
+		opts:      *opts,
 	}, nil
 }
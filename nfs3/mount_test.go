@@ -0,0 +1,33 @@
+package nfs3
+
+import (
+	"testing"
+
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+func TestFlavorAccepted(t *testing.T) {
+	const krb5 = 390003
+
+	for _, tt := range []struct {
+		offered []uint32
+		flavor  uint32
+		want    bool
+	}{
+		{nil, rpc.AuthFlavorUnix, true},
+		{nil, rpc.AuthFlavorNull, true},
+		{nil, krb5, false},
+		{[]uint32{rpc.AuthFlavorUnix}, rpc.AuthFlavorUnix, true},
+		{[]uint32{rpc.AuthFlavorUnix}, rpc.AuthFlavorNull, true},
+		{[]uint32{rpc.AuthFlavorUnix}, krb5, false},
+		{[]uint32{krb5}, rpc.AuthFlavorUnix, false},
+		{[]uint32{krb5}, rpc.AuthFlavorNull, false},
+		{[]uint32{rpc.AuthFlavorUnix, krb5}, rpc.AuthFlavorNull, false},
+		{[]uint32{krb5, rpc.AuthFlavorNull}, rpc.AuthFlavorNull, true},
+		{[]uint32{krb5, rpc.AuthFlavorNull}, rpc.AuthFlavorUnix, true},
+	} {
+		if got := flavorAccepted(tt.offered, tt.flavor); got != tt.want {
+			t.Errorf("flavorAccepted(%s, %s) = %v, want %v", flavorNames(tt.offered), flavorName(tt.flavor), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package nfs3
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/aobco/nfs/metrics"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+// Balance selects how a Target with several connections picks one for each
+// call.
+type Balance int
+
+const (
+	// RoundRobin cycles through the connections in order.
+	RoundRobin Balance = iota
+
+	// LeastLoaded picks the connection with the fewest calls in flight.
+	LeastLoaded
+)
+
+// conn returns the connection the next call should go out on.
+func (v *Target) conn() *rpc.Client {
+	if len(v.conns) < 2 {
+		return v.Client
+	}
+
+	start := int(atomic.AddUint32(&v.next, 1) % uint32(len(v.conns)))
+	if v.balance == RoundRobin {
+		return v.conns[start]
+	}
+
+	best := v.conns[start]
+	load := best.Outstanding()
+	for i := 1; i < len(v.conns) && load > 0; i++ {
+		c := v.conns[(start+i)%len(v.conns)]
+		if l := c.Outstanding(); l < load {
+			best, load = c, l
+		}
+	}
+
+	return best
+}
+
+// SetObserver installs o on every connection of the Target.
+func (v *Target) SetObserver(o metrics.Observer) {
+	for _, c := range v.conns {
+		c.SetObserver(o)
+	}
+}
+
+// Close closes every connection of the Target.
+func (v *Target) Close() error {
+	var err error
+	for _, c := range v.conns {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
+
+// dialConns opens opts.Nconnect connections to the NFS service at addr, like
+// the Linux nconnect mount option.
+func dialConns(ctx context.Context, addr string, opts *DialOptions) ([]*rpc.Client, error) {
+	n := opts.Nconnect
+	if n < 1 {
+		n = 1
+	}
+
+	m := rpc.Mapping{
+		Prog: Nfs3Prog,
+		Vers: Nfs3Vers,
+		Prot: rpc.IPProtoTCP,
+		Port: 0,
+	}
+
+	conns := make([]*rpc.Client, 0, n)
+	for i := 0; i < n; i++ {
+		client, err := DialServiceOptions(ctx, addr, m, opts)
+		if err != nil {
+			for _, c := range conns {
+				c.Close()
+			}
+			return nil, err
+		}
+
+		conns = append(conns, client)
+	}
+
+	return conns, nil
+}
@@ -0,0 +1,62 @@
+package nfs3
+
+import (
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+func TestNconnectSpreadsCalls(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+	s := rpc.NewServer()
+	s.Register(Nfs3Prog, Nfs3Vers, NFSProc3FSInfo, func(call *rpc.Call, args io.Reader) (interface{}, error) {
+		var a internal.FSINFO3args
+		decodeArgs(t, args, &a)
+
+		mu.Lock()
+		calls[call.RemoteAddr.String()]++
+		mu.Unlock()
+
+		res := &internal.FSINFO3res{}
+		res.Resok().Rtpref = 1 << 16
+		res.Resok().Wtpref = 1 << 16
+		return reply(res), nil
+	})
+	dial, dialed := redirect(t, s)
+
+	v, err := NewTargetWithOptions("192.0.2.1", rpc.AuthNull, rootFH, "/",
+		&DialOptions{Dial: dial, NFSPort: 2049, Nconnect: 3, Balance: RoundRobin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer v.Close()
+
+	if n := len(dialed()); n != 3 {
+		t.Fatalf("opened %d connections, want 3", n)
+	}
+
+	// One FSINFO was sent when the Target was set up.
+	for i := 0; i < 5; i++ {
+		if _, err := v.FSInfo(); err != nil {
+			t.Fatalf("fsinfo: %s", err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(calls) != 3 {
+		t.Fatalf("calls went out on %d connections, want 3: %v", len(calls), calls)
+	}
+	for addr, n := range calls {
+		if n != 2 {
+			t.Errorf("%s: %d calls, want 2", addr, n)
+		}
+	}
+}
@@ -1,12 +1,10 @@
 package nfs3
 
 import (
-	"fmt"
-	"github.com/aobco/log"
-	"math/rand"
+	"context"
+	"crypto/tls"
 	"net"
 	"os"
-	"os/user"
 	"syscall"
 	"time"
 
@@ -38,11 +36,16 @@ const (
 	NFSProc3Create      = 8
 	NFSProc3Mkdir       = 9
 	NFSProc3Symlink     = 10
+	NFSProc3Mknod       = 11
 	NFSProc3Remove      = 12
 	NFSProc3RmDir       = 13
 	NFSProc3Rename      = 14
+	NFSProc3Link        = 15
+	NFSProc3ReadDir     = 16
 	NFSProc3ReadDirPlus = 17
+	NFSProc3FSStat      = 18
 	NFSProc3FSInfo      = 19
+	NFSProc3PathConf    = 20
 	NFSProc3Commit      = 21
 
 	// The size in bytes of the opaque cookie verifier passed by
@@ -59,6 +62,8 @@ const (
 	NF3FIFO = 7
 )
 
+// Deprecated: Diropargs3 is no longer used; arguments are encoded with the
+// types generated from nfs3.x.
 type Diropargs3 struct {
 	FH       []byte
 	Filename string
@@ -250,75 +255,53 @@ type FSInfo struct {
 	Properties uint32
 }
 
-func DialService(addr string, prog rpc.Mapping) (*rpc.Client, error) {
-	pm, err := rpc.DialPortmapper("tcp", addr)
-	if err != nil {
-		log.Errorf("Failed to connect to portmapper: %s", err)
-		return nil, err
-	}
-	defer pm.Close()
-
-	port, err := pm.Getport(prog)
-	if err != nil {
-		return nil, err
-	}
+// FSStat is the volatile state of a file system, as returned by FSSTAT.
+type FSStat struct {
+	Attr PostOpAttr
+
+	// TotalBytes, FreeBytes and AvailBytes are the size of the file system,
+	// the free space, and the free space available to the caller.
+	TotalBytes uint64
+	FreeBytes  uint64
+	AvailBytes uint64
+
+	// TotalFiles, FreeFiles and AvailFiles are the same for file slots, i.e.
+	// inodes.
+	TotalFiles uint64
+	FreeFiles  uint64
+	AvailFiles uint64
+
+	// Invarsec is the number of seconds the values are not expected to
+	// change for. 0 means they are always changing.
+	Invarsec uint32
+}
 
-	client, err := dialService(addr, port)
-	if err != nil {
-		return nil, err
-	}
+// PathConf holds the POSIX pathconf information of a file system object, as
+// returned by PATHCONF.
+type PathConf struct {
+	Attr PostOpAttr
+
+	LinkMax         uint32
+	NameMax         uint32
+	NoTrunc         bool
+	ChownRestricted bool
+	CaseInsensitive bool
+	CasePreserving  bool
+}
 
-	return client, nil
-}
-
-func dialService(addr string, port int) (*rpc.Client, error) {
-	var (
-		ldr    *net.TCPAddr
-		client *rpc.Client
-	)
-
-	usr, err := user.Current()
-
-	// Unless explicitly configured, the target will likely reject connections
-	// from non-privileged ports.
-	if err == nil && usr.Uid == "0" {
-		r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
-
-		var p int
-		for {
-			p = r1.Intn(1024)
-			if p < 0 {
-				continue
-			}
-
-			ldr = &net.TCPAddr{
-				Port: p,
-			}
-
-			raddr := fmt.Sprintf("%s:%d", addr, port)
-			// log.Debugf("Connecting to %s", raddr)
-			client, err = rpc.DialTCP("tcp", ldr, raddr)
-			if err == nil {
-				break
-			}
-			// bind error, try again
-			if isAddrInUse(err) {
-				continue
-			}
-
-			return nil, err
-		}
-		// log.Debugf("using random port %d -> %d", p, port)
-	} else {
-		raddr := fmt.Sprintf("%s:%d", addr, port)
-		log.Debugf("Connecting to %s from unprivileged port", raddr)
-		client, err = rpc.DialTCP("tcp", ldr, raddr)
-		if err != nil {
-			return nil, err
-		}
-	}
+// DialService asks the portmapper on addr where prog is registered and
+// connects to it using the protocol given in prog.Prot. The portmapper is
+// reached over TCP, falling back to UDP for servers that only offer it there,
+// and queried with rpcbind GETADDR before falling back to portmap GETPORT.
+func DialService(addr string, prog rpc.Mapping) (*rpc.Client, error) {
+	return DialServiceTLS(addr, prog, nil)
+}
 
-	return client, nil
+// DialServiceTLS is like DialService, but upgrades the connection to
+// RPC-over-TLS when config is not nil. The portmapper is still queried in the
+// clear.
+func DialServiceTLS(addr string, prog rpc.Mapping, config *tls.Config) (*rpc.Client, error) {
+	return DialServiceOptions(context.Background(), addr, prog, &DialOptions{TLSConfig: config})
 }
 
 func isAddrInUse(err error) bool {
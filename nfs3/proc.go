@@ -0,0 +1,194 @@
+package nfs3
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+
+	"github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+// callProc sends the arguments of proc and decodes the reply into its result.
+// Status codes in the result are left for the caller to check.
+func callProc(ctx context.Context, c *rpc.Client, auth rpc.Auth, proc internal.XdrProc) error {
+	var args bytes.Buffer
+	if err := xdrMarshal(internal.XdrOut{Out: &args}, proc.GetArg()); err != nil {
+		return err
+	}
+
+	return c.CallInto(ctx, &rpc.Header{
+		Rpcvers: 2,
+		Prog:    proc.Prog(),
+		Vers:    proc.Vers(),
+		Proc:    proc.Proc(),
+		Cred:    auth,
+		Verf:    rpc.AuthNull,
+	}, net.Buffers{args.Bytes()}, func(res io.Reader) error {
+		return xdrMarshal(internal.XdrIn{In: res}, proc.GetRes())
+	})
+}
+
+// xdrMarshal runs x over t, turning the panics the generated marshallers use
+// to report errors back into an error.
+func xdrMarshal(x internal.XDR, t internal.XdrType) (err error) {
+	if _, ok := t.(internal.XdrType_void); ok {
+		return nil
+	}
+
+	defer func() {
+		if i := recover(); i != nil {
+			if e, ok := i.(internal.XdrError); ok {
+				err = e
+			} else {
+				panic(i)
+			}
+		}
+	}()
+
+	x.Marshal("", t)
+	return nil
+}
+
+// callProc is callProc on the next of the Target's connections.
+func (v *Target) callProc(ctx context.Context, proc internal.XdrProc) error {
+	return callProc(ctx, v.conn(), v.Auth, proc)
+}
+
+// nfsError returns the error for a status in an NFSv3 result.
+func nfsError(status internal.Nfsstat3) error {
+	return NFS3Error(uint32(status))
+}
+
+func fattrFrom(a *internal.Fattr3) Fattr {
+	return Fattr{
+		Type:     uint32(a.Type),
+		FileMode: a.Mode,
+		Nlink:    a.Nlink,
+		UID:      a.Uid,
+		GID:      a.Gid,
+		Filesize: a.Size,
+		Used:     a.Used,
+		SpecData: [2]uint32{a.Rdev.Specdata1, a.Rdev.Specdata2},
+		FSID:     a.Fsid,
+		Fileid:   a.Fileid,
+		Atime:    NFS3Time(a.Atime),
+		Mtime:    NFS3Time(a.Mtime),
+		Ctime:    NFS3Time(a.Ctime),
+	}
+}
+
+func postOpAttrFrom(a *internal.Post_op_attr) PostOpAttr {
+	if !a.Attributes_follow {
+		return PostOpAttr{}
+	}
+
+	return PostOpAttr{IsSet: true, Attr: fattrFrom(a.Attributes())}
+}
+
+func postOpFHFrom(h *internal.Post_op_fh3) PostOpFH3 {
+	if !h.Handle_follows {
+		return PostOpFH3{}
+	}
+
+	return PostOpFH3{IsSet: true, FH: h.Handle().Data}
+}
+
+func sattrTo(s *Sattr3) internal.Sattr3 {
+	var a internal.Sattr3
+
+	if s.Mode.SetIt {
+		a.Mode.Set_it = true
+		*a.Mode.Mode() = s.Mode.Mode
+	}
+	if s.UID.SetIt {
+		a.Uid.Set_it = true
+		*a.Uid.Uid() = s.UID.UID
+	}
+	if s.GID.SetIt {
+		a.Gid.Set_it = true
+		*a.Gid.Gid() = s.GID.UID
+	}
+	if s.Size.SetIt {
+		a.Size.Set_it = true
+		*a.Size.Size() = s.Size.Size
+	}
+
+	a.Atime.Set_it = internal.Time_how(s.Atime.SetIt)
+	if s.Atime.SetIt == SetToClientTime {
+		*a.Atime.Atime() = internal.Nfstime3(s.Atime.Time)
+	}
+	a.Mtime.Set_it = internal.Time_how(s.Mtime.SetIt)
+	if s.Mtime.SetIt == SetToClientTime {
+		*a.Mtime.Mtime() = internal.Nfstime3(s.Mtime.Time)
+	}
+
+	return a
+}
+
+func guardTo(g Sattrguard3) internal.Sattrguard3 {
+	var a internal.Sattrguard3
+
+	if g.Check != 0 {
+		a.Check = true
+		*a.Obj_ctime() = internal.Nfstime3(g.Time)
+	}
+
+	return a
+}
+
+func entryPlusFrom(e *internal.Entryplus3) *EntryPlus {
+	return &EntryPlus{
+		FileId:   e.Fileid,
+		FileName: e.Name,
+		Cookie:   e.Cookie,
+		Attr:     postOpAttrFrom(&e.Name_attributes),
+		Handle:   postOpFHFrom(&e.Name_handle),
+	}
+}
+
+func fsInfoFrom(r *internal.FSINFO3resok) *FSInfo {
+	return &FSInfo{
+		Attr:       postOpAttrFrom(&r.Obj_attributes),
+		RTMax:      r.Rtmax,
+		RTPref:     r.Rtpref,
+		RTMult:     r.Rtmult,
+		WTMax:      r.Wtmax,
+		WTPref:     r.Wtpref,
+		WTMult:     r.Wtmult,
+		DTPref:     r.Dtpref,
+		Size:       r.Maxfilesize,
+		TimeDelta:  NFS3Time(r.Time_delta),
+		Properties: r.Properties,
+	}
+}
+
+func fsStatFrom(r *internal.FSSTAT3resok) *FSStat {
+	return &FSStat{
+		Attr:       postOpAttrFrom(&r.Obj_attributes),
+		TotalBytes: r.Tbytes,
+		FreeBytes:  r.Fbytes,
+		AvailBytes: r.Abytes,
+		TotalFiles: r.Tfiles,
+		FreeFiles:  r.Ffiles,
+		AvailFiles: r.Afiles,
+		Invarsec:   r.Invarsec,
+	}
+}
+
+func pathConfFrom(r *internal.PATHCONF3resok) *PathConf {
+	return &PathConf{
+		Attr:            postOpAttrFrom(&r.Obj_attributes),
+		LinkMax:         r.Linkmax,
+		NameMax:         r.Name_max,
+		NoTrunc:         r.No_trunc,
+		ChownRestricted: r.Chown_restricted,
+		CaseInsensitive: r.Case_insensitive,
+		CasePreserving:  r.Case_preserving,
+	}
+}
+
+func diropargs(fh []byte, name string) internal.Diropargs3 {
+	return internal.Diropargs3{Dir: internal.Nfs_fh3{Data: fh}, Name: name}
+}
@@ -0,0 +1,76 @@
+package nfs3
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/aobco/nfs/internal"
+)
+
+func TestGetattrDecodesAttributes(t *testing.T) {
+	want := internal.Fattr3{
+		Type:   internal.NF3CHR,
+		Mode:   0620,
+		Nlink:  2,
+		Uid:    1000,
+		Gid:    5,
+		Size:   1 << 40,
+		Used:   4096,
+		Rdev:   internal.Specdata3{Specdata1: 136, Specdata2: 3},
+		Fsid:   0xfeed,
+		Fileid: 0xdeadbeef,
+		Atime:  internal.Nfstime3{Seconds: 1, Nseconds: 2},
+		Mtime:  internal.Nfstime3{Seconds: 3, Nseconds: 4},
+		Ctime:  internal.Nfstime3{Seconds: 5, Nseconds: 6},
+	}
+
+	s := newNFSServer()
+	s.handle(NFSProc3Getattr, func(args io.Reader) internal.XdrType {
+		var a internal.GETATTR3args
+		decodeArgs(t, args, &a)
+		if string(a.Object.Data) != string(rootFH) {
+			t.Errorf("GETATTR of %q, want %q", a.Object.Data, rootFH)
+		}
+
+		res := &internal.GETATTR3res{}
+		res.Resok().Obj_attributes = want
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	attr, err := v.Getattr("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(*attr, fattrFrom(&want)) {
+		t.Errorf("got %+v, want %+v", *attr, fattrFrom(&want))
+	}
+	if attr.Type != NF3Chr || attr.SpecData != [2]uint32{136, 3} || attr.Filesize != 1<<40 {
+		t.Errorf("got type %d, rdev %v, size %d", attr.Type, attr.SpecData, attr.Filesize)
+	}
+}
+
+func TestXdrMarshalReportsErrors(t *testing.T) {
+	var b bytes.Buffer
+	if err := xdrMarshal(internal.XdrOut{Out: &b}, &internal.Fattr3{Type: internal.NF3REG}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Truncated input fails the decode rather than panicking.
+	var a internal.Fattr3
+	if err := xdrMarshal(internal.XdrIn{In: bytes.NewReader(b.Bytes()[:b.Len()-1])}, &a); err == nil {
+		t.Error("decoding truncated attributes succeeded")
+	}
+
+	// A failed result is its status and the arm for it.
+	var res internal.GETATTR3res
+	if err := xdrMarshal(internal.XdrIn{In: bytes.NewReader([]byte{0, 0, 0, 1, 0, 0, 0, 0})}, &res); err != nil {
+		t.Errorf("decoding a failed GETATTR: %s", err)
+	}
+	if res.Status != internal.NFS3ERR_PERM {
+		t.Errorf("status %s, want NFS3ERR_PERM", res.Status)
+	}
+}
@@ -3,14 +3,19 @@ package rpc
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"encoding/binary"
 	"fmt"
 	"github.com/aobco/log"
 	"io"
 	"math/rand"
 	"net"
+	"os"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/aobco/nfs/metrics"
 	"github.com/aobco/nfs/nfs3/xdr"
 )
 
@@ -30,6 +35,14 @@ const (
 
 const (
 	RpcMismatch = iota
+	AuthError
+)
+
+// Programs whose results carry a status word the observer reports.
+const (
+	nfsProg      = 100003
+	mountProg    = 100005
+	mountProcMnt = 1
 )
 
 var xid uint32
@@ -42,8 +55,65 @@ func init() {
 // added by zema1
 var DefaultReadTimeout = time.Second * 120
 
+// DefaultSlotTableSize is the number of calls a Client allows in flight on a
+// single connection. Once every slot is taken, Call blocks until a reply frees
+// one up.
+var DefaultSlotTableSize = 64
+
+// DefaultRetransmitTimeout is how long a Client on a datagram transport waits
+// for a reply before resending the call. The interval doubles after every
+// retransmission, up to MaxRetransmitTimeout.
+var DefaultRetransmitTimeout = time.Second
+
+// MaxRetransmitTimeout caps the exponential backoff between retransmissions.
+var MaxRetransmitTimeout = 30 * time.Second
+
+// DefaultRetransmits is how many times a call is resent over a datagram
+// transport before giving up.
+var DefaultRetransmits = 5
+
+// transport moves whole RPC messages over a connection.
+type transport interface {
+	io.WriteCloser
+
+	// recv returns the next message received from the connection.
+	recv() (io.ReadSeeker, error)
+
+	// recvStream is like recv, but may return the message before it has
+	// been read off the connection. It must be read to EOF.
+	recvStream() (io.Reader, error)
+
+	// writev sends the concatenation of bufs as one message.
+	writev(bufs net.Buffers) (int, error)
+
+	SetTimeout(d time.Duration)
+
+	// LocalAddr and RemoteAddr return the addresses of the two ends.
+	LocalAddr() net.Addr
+	RemoteAddr() net.Addr
+}
+
+// Client multiplexes calls over a single connection. Requests are written as
+// soon as a slot is free, and a reader goroutine matches replies back to their
+// callers by XID, so replies may arrive in any order.
 type Client struct {
-	*tcpTransport
+	transport
+
+	mu sync.Mutex
+
+	timeout time.Duration
+
+	// retransmission settings, only used over datagram transports
+	retransTimeout time.Duration
+	retrans        int
+
+	slots    chan struct{}
+	pending  map[uint32]*pendingCall
+	observer metrics.Observer
+
+	// err is set and done closed once the reader goroutine stops.
+	err  error
+	done chan struct{}
 }
 
 func DialTCP(network string, ldr *net.TCPAddr, addr string) (*Client, error) {
@@ -57,13 +127,402 @@ func DialTCP(network string, ldr *net.TCPAddr, addr string) (*Client, error) {
 		return nil, err
 	}
 
-	t := &tcpTransport{
-		r:       bufio.NewReader(conn),
-		wc:      conn,
-		timeout: DefaultReadTimeout,
+	return NewClient(conn), nil
+}
+
+// DialUDP returns a Client that sends each call as a single datagram and
+// retransmits it until a reply arrives.
+func DialUDP(network string, ldr *net.UDPAddr, addr string) (*Client, error) {
+	a, err := net.ResolveUDPAddr(network, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.DialUDP(a.Network(), ldr, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUDPClient(conn), nil
+}
+
+// NewUDPClient returns a Client that sends one call per datagram on conn and
+// retransmits calls that go unanswered.
+func NewUDPClient(conn *net.UDPConn) *Client {
+	c := newClient(&udpTransport{
+		conn:    conn,
+		timeout: int64(DefaultReadTimeout),
+	})
+	c.retransTimeout = DefaultRetransmitTimeout
+	c.retrans = DefaultRetransmits
+
+	return c
+}
+
+// NewClient starts a Client on an already established stream connection.
+func NewClient(conn net.Conn) *Client {
+	return newClient(&tcpTransport{
+		r:               bufio.NewReader(conn),
+		wc:              conn,
+		timeout:         int64(DefaultReadTimeout),
+		maxRecordSize:   int64(DefaultMaxRecordSize),
+		maxFragmentSize: int64(DefaultMaxFragmentSize),
+	})
+}
+
+func newClient(t transport) *Client {
+	c := &Client{
+		transport: t,
+		timeout:   DefaultReadTimeout,
+		slots:     make(chan struct{}, DefaultSlotTableSize),
+		pending:   make(map[uint32]*pendingCall),
+		done:      make(chan struct{}),
+	}
+	go c.readLoop()
+
+	return c
+}
+
+// SetTimeout bounds how long a call waits for its reply, including any
+// retransmissions. Zero disables the timeout.
+func (c *Client) SetTimeout(d time.Duration) {
+	c.mu.Lock()
+	c.timeout = d
+	c.mu.Unlock()
+
+	c.transport.SetTimeout(d)
+}
+
+// SetMaxRecordSize changes the largest reply record accepted on a stream
+// transport. Zero removes the limit.
+func (c *Client) SetMaxRecordSize(n int) {
+	if t, ok := c.transport.(*tcpTransport); ok {
+		atomic.StoreInt64(&t.maxRecordSize, int64(n))
+	}
+}
+
+// SetMaxFragmentSize makes a stream transport split messages longer than n
+// bytes into several record fragments. Zero sends every message whole.
+func (c *Client) SetMaxFragmentSize(n int) {
+	if t, ok := c.transport.(*tcpTransport); ok {
+		atomic.StoreInt64(&t.maxFragmentSize, int64(n))
+	}
+}
+
+// SetRetransmit changes the initial retransmission interval and the number of
+// retransmissions for a Client on a datagram transport. It has no effect on
+// stream transports, which never lose messages.
+func (c *Client) SetRetransmit(timeout time.Duration, retries int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.retransTimeout == 0 {
+		return
+	}
+
+	c.retransTimeout = timeout
+	c.retrans = retries
+}
+
+// SetSlotTableSize changes how many calls may be outstanding at once. Calls
+// already waiting for a slot keep waiting on the previous table.
+func (c *Client) SetSlotTableSize(n int) {
+	if n < 1 {
+		n = 1
+	}
+
+	c.mu.Lock()
+	c.slots = make(chan struct{}, n)
+	c.mu.Unlock()
+}
+
+// pendingCall is a call waiting for its reply.
+type pendingCall struct {
+	ch  chan io.ReadSeeker
+	err error
+
+	// decode, if set, reads the result of a successful reply straight off
+	// the connection on the reader goroutine. The reply is then delivered as
+	// a nil ReadSeeker, with decode's error in err.
+	decode func(res io.Reader) error
+	rec    io.Reader
+	size   int
+
+	// the first word of the result, kept for the observer
+	status [4]byte
+	read   int
+}
+
+// Read feeds decode from the record being received.
+func (p *pendingCall) Read(b []byte) (int, error) {
+	n, err := p.rec.Read(b)
+	if p.read < len(p.status) {
+		copy(p.status[p.read:], b[:n])
+	}
+	p.read += n
+
+	return n, err
+}
+
+func (p *pendingCall) deliver(res io.ReadSeeker, err error) {
+	p.err = err
+	p.ch <- res
+}
+
+// readLoop dispatches every record read from the connection to the call
+// waiting on its XID. Replies nobody is waiting for (e.g. the caller timed
+// out) are dropped.
+func (c *Client) readLoop() {
+	for {
+		rec, err := c.recvStream()
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+
+		err = c.dispatch(rec)
+		if err == nil {
+			_, err = io.Copy(io.Discard, rec)
+		}
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+	}
+}
+
+// dispatch hands the reply in rec to the call waiting for it. Only errors
+// that leave the connection unusable are returned.
+func (c *Client) dispatch(rec io.Reader) error {
+	// xid, msg_type, reply_stat, verifier flavor and length, accept_stat
+	var hdr [24]byte
+
+	n, err := io.ReadFull(rec, hdr[:4])
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		log.Debugf("rpc: dropping short reply")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	xid := binary.BigEndian.Uint32(hdr[:])
+
+	c.mu.Lock()
+	p, ok := c.pending[xid]
+	delete(c.pending, xid)
+	c.mu.Unlock()
+
+	if !ok {
+		log.Debugf("rpc: dropping reply for unknown xid %x", xid)
+		return nil
+	}
+
+	if p.decode != nil {
+		m, err := io.ReadFull(rec, hdr[4:])
+		n += m
+
+		// Successful replies with an empty verifier, which is all of them
+		// short of RPCSEC_GSS, are decoded straight off the connection.
+		if err == nil && binary.BigEndian.Uint32(hdr[4:]) == 1 &&
+			binary.BigEndian.Uint32(hdr[8:]) == MsgAccepted &&
+			binary.BigEndian.Uint32(hdr[16:]) == 0 &&
+			binary.BigEndian.Uint32(hdr[20:]) == Success {
+			p.rec = rec
+			err = p.decode(p)
+			if _, cerr := io.Copy(io.Discard, rec); cerr != nil {
+				p.deliver(nil, cerr)
+				return cerr
+			}
+
+			p.size = n + p.read
+			p.deliver(nil, err)
+			return nil
+		} else if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			p.deliver(nil, err)
+			return err
+		}
+	}
+
+	buf, err := readRest(rec, hdr[:n])
+	if err != nil {
+		p.deliver(nil, err)
+		return err
+	}
+
+	p.deliver(bytes.NewReader(buf), nil)
+	return nil
+}
+
+// shutdown fails every outstanding and future call with err.
+func (c *Client) shutdown(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.err = err
+	c.pending = make(map[uint32]*pendingCall)
+	close(c.done)
+}
+
+func (c *Client) acquireSlot(ctx context.Context) (chan struct{}, error) {
+	c.mu.Lock()
+	slots := c.slots
+	c.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return slots, nil
+	case <-c.done:
+		return nil, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// SetObserver installs an observer that is told about every call made
+// through the Client. Pass nil to remove it.
+func (c *Client) SetObserver(o metrics.Observer) {
+	c.mu.Lock()
+	c.observer = o
+	c.mu.Unlock()
+}
+
+// hasStatus reports whether the result of a procedure starts with a status
+// word: every NFS procedure but NULL, and MOUNT's MNT.
+func hasStatus(prog, proc uint32) bool {
+	return (prog == nfsProg && proc != 0) || (prog == mountProg && proc == mountProcMnt)
+}
+
+// peekStatus reads the first word of a result without consuming it.
+func peekStatus(res io.ReadSeeker) (uint32, bool) {
+	pos, err := res.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, false
+	}
+
+	status, err := xdr.ReadUint32(res)
+	if _, serr := res.Seek(pos, io.SeekStart); err != nil || serr != nil {
+		return 0, false
+	}
+
+	return status, true
+}
+
+// Outstanding returns the number of calls waiting for a reply.
+func (c *Client) Outstanding() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.pending)
+}
+
+// forget abandons the call with xid. It returns false if the reader has
+// already taken the call on.
+func (c *Client) forget(xid uint32) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.pending[xid]
+	delete(c.pending, xid)
+	return ok
+}
+
+// abandon gives up on a call. A call whose reply is being decoded into the
+// caller's memory is waited for, so that nothing writes there after the
+// caller has returned.
+func (c *Client) abandon(xid uint32, p *pendingCall) {
+	if !c.forget(xid) && p.decode != nil {
+		select {
+		case <-p.ch:
+		case <-c.done:
+		}
+	}
+}
+
+// roundTrip sends an encoded message and waits for the reply with the same
+// XID. A nil reply with a nil error means the reply was decoded by p.decode.
+// Cancelling ctx abandons the call but leaves the connection usable; a
+// reply that arrives afterwards is dropped.
+func (c *Client) roundTrip(ctx context.Context, xid uint32, msg net.Buffers, p *pendingCall, ev *metrics.Event) (io.ReadSeeker, error) {
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return nil, c.err
+	}
+	c.pending[xid] = p
+	timeout, interval, retries := c.timeout, c.retransTimeout, c.retrans
+	c.mu.Unlock()
+
+	if _, err := c.writev(msg); err != nil {
+		c.abandon(xid, p)
+		return nil, err
+	}
+
+	if ev != nil {
+		ev.BytesSent += buffersLen(msg)
+	}
+
+	var expired <-chan time.Time
+	if timeout != 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	var (
+		resend  <-chan time.Time
+		retimer *time.Timer
+		retrans = retries
+	)
+	if interval > 0 {
+		retimer = time.NewTimer(interval)
+		defer retimer.Stop()
+		resend = retimer.C
 	}
 
-	return &Client{t}, nil
+	for {
+		select {
+		case res := <-p.ch:
+			return res, p.err
+		case <-c.done:
+			// the reply may have been delivered right before the reader stopped
+			select {
+			case res := <-p.ch:
+				return res, p.err
+			default:
+				return nil, c.err
+			}
+		case <-expired:
+			c.abandon(xid, p)
+			return nil, fmt.Errorf("rpc: no reply for xid %x: %w", xid, os.ErrDeadlineExceeded)
+		case <-ctx.Done():
+			c.abandon(xid, p)
+			return nil, ctx.Err()
+		case <-resend:
+			if retries == 0 {
+				c.abandon(xid, p)
+				return nil, fmt.Errorf("rpc: no reply for xid %x after %d retransmissions: %w", xid, retrans, os.ErrDeadlineExceeded)
+			}
+			retries--
+
+			// Any earlier reply to this XID that shows up late is a
+			// duplicate and gets dropped by the reader.
+			log.Debugf("rpc: retransmitting xid %x", xid)
+			if _, err := c.writev(msg); err != nil {
+				c.abandon(xid, p)
+				return nil, err
+			}
+
+			if ev != nil {
+				ev.BytesSent += buffersLen(msg)
+				ev.Retries++
+			}
+
+			if interval *= 2; interval > MaxRetransmitTimeout {
+				interval = MaxRetransmitTimeout
+			}
+			retimer.Reset(interval)
+		}
+	}
 }
 
 type message struct {
@@ -73,108 +532,204 @@ type message struct {
 }
 
 func (c *Client) Call(call interface{}) (io.ReadSeeker, error) {
-	retries := 1
+	return c.CallContext(context.Background(), call)
+}
+
+// CallContext is like Call, but gives up waiting for a slot or for the reply
+// once ctx is done. Other calls sharing the connection are not affected.
+func (c *Client) CallContext(ctx context.Context, call interface{}) (io.ReadSeeker, error) {
+	c.mu.Lock()
+	obs := c.observer
+	c.mu.Unlock()
+
+	if obs == nil {
+		return c.call(ctx, call, nil)
+	}
 
+	ev := new(metrics.Event)
+	start := time.Now()
+	res, err := c.call(ctx, call, ev)
+	ev.Duration = time.Since(start)
+	ev.Err = err
+
+	if err == nil && hasStatus(ev.Prog, ev.Proc) {
+		ev.Status, ev.HasStatus = peekStatus(res)
+	}
+
+	obs.ObserveCall(ev)
+	return res, err
+}
+
+// call sends a call and decodes the reply header. ev, if not nil, is filled
+// in for the observer.
+func (c *Client) call(ctx context.Context, call interface{}, ev *metrics.Event) (io.ReadSeeker, error) {
 	msg := &message{
 		Xid:  atomic.AddUint32(&xid, 1),
 		Body: call,
 	}
 
-retry:
 	w := new(bytes.Buffer)
 	if err := xdr.Write(w, msg); err != nil {
 		return nil, err
 	}
 
-	if _, err := c.Write(w.Bytes()); err != nil {
+	return c.send(ctx, msg.Xid, net.Buffers{w.Bytes()}, nil, ev)
+}
+
+// send transmits an encoded call and decodes the reply header. With decode
+// set, the result of a successful reply is passed to it rather than returned.
+func (c *Client) send(ctx context.Context, id uint32, msg net.Buffers, decode func(res io.Reader) error, ev *metrics.Event) (io.ReadSeeker, error) {
+	retries := 1
+
+	if ev != nil && len(msg) > 0 && len(msg[0]) >= 24 {
+		// xid, msg_type and rpcvers come before the program triple
+		hdr := msg[0]
+		ev.Prog = binary.BigEndian.Uint32(hdr[12:])
+		ev.Vers = binary.BigEndian.Uint32(hdr[16:])
+		ev.Proc = binary.BigEndian.Uint32(hdr[20:])
+	}
+
+	slots, err := c.acquireSlot(ctx)
+	if err != nil {
 		return nil, err
 	}
+	defer func() { <-slots }()
+
+retry:
+	p := &pendingCall{
+		ch:     make(chan io.ReadSeeker, 1),
+		decode: decode,
+	}
 
-	res, err := c.recv()
+	res, err := c.roundTrip(ctx, id, msg, p, ev)
 	if err != nil {
 		return nil, err
 	}
 
+	if res == nil {
+		// decoded on the reader goroutine
+		if ev != nil {
+			ev.BytesReceived += p.size
+			if hasStatus(ev.Prog, ev.Proc) && p.read >= 4 {
+				ev.Status, ev.HasStatus = binary.BigEndian.Uint32(p.status[:]), true
+			}
+		}
+		return nil, p.err
+	}
+
+	if ev != nil {
+		if sized, ok := res.(interface{ Size() int64 }); ok {
+			ev.BytesReceived += int(sized.Size())
+		}
+	}
+
 	xid, err := xdr.ReadUint32(res)
 	if err != nil {
-		return nil, err
+		return nil, malformed("reading xid: %s", err)
 	}
 
-	if xid != msg.Xid {
-		return nil, fmt.Errorf("xid did not match, expected: %x, received: %x", msg.Xid, xid)
+	if xid != id {
+		return nil, malformed("xid did not match, expected: %x, received: %x", id, xid)
 	}
 
 	mtype, err := xdr.ReadUint32(res)
 	if err != nil {
-		return nil, err
+		return nil, malformed("reading message type: %s", err)
 	}
 
 	if mtype != 1 {
-		return nil, fmt.Errorf("message as not a reply: %d", mtype)
+		return nil, malformed("message as not a reply: %d", mtype)
 	}
 
 	status, err := xdr.ReadUint32(res)
 	if err != nil {
-		return nil, err
+		return nil, malformed("reading reply status: %s", err)
 	}
 
 	switch status {
 	case MsgAccepted:
 
-		// padding
+		// verifier flavor
 		_, err = xdr.ReadUint32(res)
 		if err != nil {
-			panic(err.Error())
+			return nil, malformed("reading verifier: %s", err)
 		}
 
 		opaque_len, err := xdr.ReadUint32(res)
 		if err != nil {
-			panic(err.Error())
+			return nil, malformed("reading verifier: %s", err)
+		}
+
+		// opaque data is padded to a multiple of four bytes
+		_, err = res.Seek(int64((opaque_len+3)&^3), io.SeekCurrent)
+		if err != nil {
+			return nil, malformed("skipping verifier: %s", err)
 		}
 
-		_, err = res.Seek(int64(opaque_len), io.SeekCurrent)
+		acceptStatus, err := xdr.ReadUint32(res)
 		if err != nil {
-			panic(err.Error())
+			return nil, malformed("reading accept status: %s", err)
 		}
 
-		acceptStatus, _ := xdr.ReadUint32(res)
+		rpcErr := &Error{Stat: MsgAccepted, AcceptStat: acceptStatus}
 
 		switch acceptStatus {
 		case Success:
+			if decode != nil {
+				if ev != nil && hasStatus(ev.Prog, ev.Proc) {
+					ev.Status, ev.HasStatus = peekStatus(res)
+				}
+				return nil, decode(res)
+			}
 			return res, nil
-		case ProgUnavail:
-			return nil, fmt.Errorf("rpc: PROG_UNAVAIL - server does not recognize the program number")
-		case ProgMismatch:
-			return nil, fmt.Errorf("rpc: PROG_MISMATCH - program version does not exist on the server")
-		case ProcUnavail:
-			return nil, fmt.Errorf("rpc: PROC_UNAVAIL - unrecognized procedure number")
 		case GarbageArgs:
 			// emulate Linux behaviour for GARBAGE_ARGS
 			if retries > 0 {
 				log.Debugf("Retrying on GARBAGE_ARGS per linux semantics")
 				retries--
+				if ev != nil {
+					ev.Retries++
+				}
 				goto retry
 			}
-
-			return nil, fmt.Errorf("rpc: GARBAGE_ARGS - rpc arguments cannot be XDR decoded")
-		case SystemErr:
-			return nil, fmt.Errorf("rpc: SYSTEM_ERR - unknown error on server")
-		default:
-			return nil, fmt.Errorf("rpc: unknown accepted status error: %d", acceptStatus)
+		case ProgMismatch:
+			if rpcErr.Low, err = xdr.ReadUint32(res); err != nil {
+				return nil, malformed("reading mismatch info: %s", err)
+			}
+			if rpcErr.High, err = xdr.ReadUint32(res); err != nil {
+				return nil, malformed("reading mismatch info: %s", err)
+			}
 		}
 
+		return nil, rpcErr
+
 	case MsgDenied:
-		rejectStatus, _ := xdr.ReadUint32(res)
+		rejectStatus, err := xdr.ReadUint32(res)
+		if err != nil {
+			return nil, malformed("reading reject status: %s", err)
+		}
+
+		rpcErr := &Error{Stat: MsgDenied, RejectStat: rejectStatus}
+
 		switch rejectStatus {
 		case RpcMismatch:
-
+			if rpcErr.Low, err = xdr.ReadUint32(res); err != nil {
+				return nil, malformed("reading mismatch info: %s", err)
+			}
+			if rpcErr.High, err = xdr.ReadUint32(res); err != nil {
+				return nil, malformed("reading mismatch info: %s", err)
+			}
+		case AuthError:
+			if rpcErr.AuthStat, err = xdr.ReadUint32(res); err != nil {
+				return nil, malformed("reading auth status: %s", err)
+			}
 		default:
-			return nil, fmt.Errorf("rejectedStatus was not valid: %d", rejectStatus)
+			return nil, malformed("rejectedStatus was not valid: %d", rejectStatus)
 		}
 
+		return nil, rpcErr
+
 	default:
-		return nil, fmt.Errorf("rejectedStatus was not valid: %d", status)
+		return nil, malformed("reply status was not valid: %d", status)
 	}
-
-	panic("unreachable")
 }
@@ -0,0 +1,326 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+func TestClientMultiplexes(t *testing.T) {
+	const n = 8
+
+	var (
+		mu      sync.Mutex
+		arrived int
+		all     = make(chan struct{})
+	)
+	s := NewServer()
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		arg, err := xdr.ReadUint32(args)
+		if err != nil {
+			return nil, ErrGarbageArgs
+		}
+
+		// Every call must be outstanding at once, then the replies go
+		// out in reverse order.
+		mu.Lock()
+		if arrived++; arrived == n {
+			close(all)
+		}
+		mu.Unlock()
+
+		select {
+		case <-all:
+		case <-time.After(5 * time.Second):
+			return nil, errors.New("calls were not sent concurrently")
+		}
+		time.Sleep(time.Duration(n-arg) * 5 * time.Millisecond)
+
+		return arg, nil
+	})
+	c := serve(t, s)
+
+	var wg sync.WaitGroup
+	for i := uint32(0); i < n; i++ {
+		wg.Add(1)
+		go func(arg uint32) {
+			defer wg.Done()
+
+			if v, err := call(c, 1, arg); err != nil || v != arg {
+				t.Errorf("call %d: got %d, %v", arg, v, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if c.Outstanding() != 0 {
+		t.Errorf("%d calls still outstanding", c.Outstanding())
+	}
+}
+
+func TestClientSlotTable(t *testing.T) {
+	const slots = 2
+
+	var (
+		mu     sync.Mutex
+		active int
+		most   int
+	)
+	s := NewServer()
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		mu.Lock()
+		if active++; active > most {
+			most = active
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return uint32(0), nil
+	})
+	c := serve(t, s)
+	c.SetSlotTableSize(slots)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4*slots; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := call(c, 1, 0); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if most != slots {
+		t.Errorf("%d calls outstanding at once, want %d", most, slots)
+	}
+}
+
+// lossyServer serves s over UDP, dropping the first drop datagrams of every
+// call. It returns the address to send to and the datagrams received per XID.
+func lossyServer(t *testing.T, s *Server, laddr string, drop int) (string, func(xid uint32) int) {
+	t.Helper()
+
+	a, err := net.ResolveUDPAddr("udp", laddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := net.ListenUDP("udp", a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	var (
+		mu       sync.Mutex
+		received = make(map[uint32]int)
+	)
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			xid := binary.BigEndian.Uint32(buf)
+			mu.Lock()
+			received[xid]++
+			seen := received[xid]
+			mu.Unlock()
+			if seen <= drop {
+				continue
+			}
+
+			if reply, ok := s.handle(addr, bytes.NewReader(append([]byte(nil), buf[:n]...))); ok {
+				conn.WriteTo(reply, addr)
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String(), func(xid uint32) int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return received[xid]
+	}
+}
+
+func TestClientRetransmits(t *testing.T) {
+	s := NewServer()
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		return call.Xid, nil
+	})
+
+	addr, received := lossyServer(t, s, "127.0.0.1:0", 2)
+	c, err := DialUDP("udp", nil, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetRetransmit(10*time.Millisecond, 3)
+
+	xid, err := call(c, 1, 0)
+	if err != nil {
+		t.Fatalf("call: %s", err)
+	}
+	if n := received(xid); n != 3 {
+		t.Errorf("server received the call %d times, want 3", n)
+	}
+}
+
+func TestClientGivesUpRetransmitting(t *testing.T) {
+	addr, _ := lossyServer(t, NewServer(), "127.0.0.1:0", 1<<30)
+	c, err := DialUDP("udp", nil, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetRetransmit(5*time.Millisecond, 2)
+
+	if _, err := call(c, 1, 0); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("call to a server that never answers: %v, want a deadline error", err)
+	}
+}
+
+func TestClientSurvivesPortUnreachable(t *testing.T) {
+	// Nothing listens on addr at first, so the call draws an ICMP port
+	// unreachable.
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := conn.LocalAddr().String()
+	conn.Close()
+
+	c, err := DialUDP("udp", nil, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetRetransmit(20*time.Millisecond, 0)
+
+	if _, err := call(c, 1, 0); err == nil {
+		t.Fatal("call to a closed port succeeded")
+	}
+
+	s := NewServer()
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		return uint32(1), nil
+	})
+	lossyServer(t, s, addr, 0)
+
+	if _, err := call(c, 1, 0); err != nil {
+		t.Errorf("call once the server is up: %s", err)
+	}
+}
+
+// BenchmarkCall measures a call returning 64KiB through the reflection based
+// Call and through CallInto, which decodes the reply off the connection into
+// a buffer owned by the caller. The allocations reported include those of the
+// server, which runs in the same process.
+func BenchmarkCall(b *testing.B) {
+	payload := make([]byte, 64<<10)
+
+	s := NewServer()
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		return payload, nil
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer l.Close()
+	go s.Serve(l)
+
+	c, err := DialTCP("tcp", nil, l.Addr().String())
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer c.Close()
+
+	hdr := Header{Rpcvers: 2, Prog: testProg, Vers: testVers, Proc: 1}
+	args := net.Buffers{xdr.AppendUint32(nil, 0)}
+
+	b.Run("Call", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(payload)))
+
+		for i := 0; i < b.N; i++ {
+			res, err := c.Call(&testCall{Header: hdr})
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := xdr.ReadOpaque(res); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("CallInto", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(payload)))
+
+		buf := make([]byte, len(payload))
+		decode := func(res io.Reader) error {
+			if _, err := xdr.ReadUint32(res); err != nil {
+				return err
+			}
+			_, err := io.ReadFull(res, buf)
+			return err
+		}
+
+		for i := 0; i < b.N; i++ {
+			if err := c.CallInto(context.Background(), &hdr, args, decode); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestClientSettingsWhileCalling(t *testing.T) {
+	s := NewServer()
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		return uint32(0), nil
+	})
+	addr, _ := lossyServer(t, s, "127.0.0.1:0", 0)
+	c, err := DialUDP("udp", nil, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// Run with the race detector to check the settings are safe to change
+	// on a Client in use.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			c.SetTimeout(time.Duration(10+i) * time.Second)
+			c.SetRetransmit(time.Duration(1+i)*time.Second, 5)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		if _, err := call(c, 1, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	<-done
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/aobco/nfs/metrics"
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+// CallInto is a fast path for calls that move bulk data, such as NFS READ and
+// WRITE. hdr is encoded by hand rather than through reflection, and args,
+// already XDR encoded, go out with the header in a single vectored write, so
+// a payload among them is never copied.
+//
+// The result of a successful reply is passed to decode, which usually runs
+// on the connection's reader goroutine and reads the result straight off the
+// connection; whatever decode leaves unread is discarded. decode must not
+// keep res. If ctx is done while decode runs, CallInto waits for it to
+// finish, so decode may safely fill memory owned by the caller.
+func (c *Client) CallInto(ctx context.Context, hdr *Header, args net.Buffers, decode func(res io.Reader) error) error {
+	c.mu.Lock()
+	obs := c.observer
+	c.mu.Unlock()
+
+	id := atomic.AddUint32(&xid, 1)
+
+	head := make([]byte, 0, 40+len(hdr.Cred.Body)+len(hdr.Verf.Body)+6)
+	head = xdr.AppendUint32(head, id)
+	head = xdr.AppendUint32(head, 0) // CALL
+	head = hdr.appendTo(head)
+
+	msg := make(net.Buffers, 0, len(args)+1)
+	msg = append(msg, head)
+	msg = append(msg, args...)
+
+	if obs == nil {
+		_, err := c.send(ctx, id, msg, decode, nil)
+		return err
+	}
+
+	ev := new(metrics.Event)
+	start := time.Now()
+	_, err := c.send(ctx, id, msg, decode, ev)
+	ev.Duration = time.Since(start)
+	ev.Err = err
+
+	obs.ObserveCall(ev)
+	return err
+}
+
+// appendTo appends the XDR encoding of h to b.
+func (h *Header) appendTo(b []byte) []byte {
+	b = xdr.AppendUint32(b, h.Rpcvers)
+	b = xdr.AppendUint32(b, h.Prog)
+	b = xdr.AppendUint32(b, h.Vers)
+	b = xdr.AppendUint32(b, h.Proc)
+	b = xdr.AppendUint32(b, h.Cred.Flavor)
+	b = xdr.AppendOpaque(b, h.Cred.Body)
+	b = xdr.AppendUint32(b, h.Verf.Flavor)
+	return xdr.AppendOpaque(b, h.Verf.Body)
+}
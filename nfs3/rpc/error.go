@@ -0,0 +1,126 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Why authentication failed (auth_stat in RFC 5531).
+const (
+	AuthOk = iota
+	AuthBadCred
+	AuthRejectedCred
+	AuthBadVerf
+	AuthRejectedVerf
+	AuthTooWeak
+	AuthInvalidResp
+	AuthFailed
+)
+
+// ErrMalformedReply is wrapped by the error returned when a reply cannot be
+// decoded.
+var ErrMalformedReply = errors.New("rpc: malformed reply")
+
+// Sentinel errors for use with errors.Is. They match any *Error with the same
+// accept or reject status; ErrAuthError matches every authentication failure.
+var (
+	ErrProgUnavail  = &Error{Stat: MsgAccepted, AcceptStat: ProgUnavail}
+	ErrProgMismatch = &Error{Stat: MsgAccepted, AcceptStat: ProgMismatch}
+	ErrProcUnavail  = &Error{Stat: MsgAccepted, AcceptStat: ProcUnavail}
+	ErrGarbageArgs  = &Error{Stat: MsgAccepted, AcceptStat: GarbageArgs}
+	ErrSystemErr    = &Error{Stat: MsgAccepted, AcceptStat: SystemErr}
+	ErrRpcMismatch  = &Error{Stat: MsgDenied, RejectStat: RpcMismatch}
+	ErrAuthError    = &Error{Stat: MsgDenied, RejectStat: AuthError}
+)
+
+// Error describes a call the server did not execute: either an accepted reply
+// with a status other than Success, or a denied reply.
+type Error struct {
+	// Stat is MsgAccepted or MsgDenied.
+	Stat uint32
+
+	// AcceptStat is set when Stat is MsgAccepted.
+	AcceptStat uint32
+
+	// RejectStat is set when Stat is MsgDenied, and AuthStat when RejectStat
+	// is AuthError.
+	RejectStat uint32
+	AuthStat   uint32
+
+	// Low and High are the supported version range for ProgMismatch and
+	// RpcMismatch.
+	Low, High uint32
+}
+
+var acceptStatNames = map[uint32]string{
+	ProgUnavail:  "PROG_UNAVAIL - server does not recognize the program number",
+	ProgMismatch: "PROG_MISMATCH - program version does not exist on the server",
+	ProcUnavail:  "PROC_UNAVAIL - unrecognized procedure number",
+	GarbageArgs:  "GARBAGE_ARGS - rpc arguments cannot be XDR decoded",
+	SystemErr:    "SYSTEM_ERR - unknown error on server",
+}
+
+var authStatNames = map[uint32]string{
+	AuthOk:           "AUTH_OK",
+	AuthBadCred:      "AUTH_BADCRED",
+	AuthRejectedCred: "AUTH_REJECTEDCRED",
+	AuthBadVerf:      "AUTH_BADVERF",
+	AuthRejectedVerf: "AUTH_REJECTEDVERF",
+	AuthTooWeak:      "AUTH_TOOWEAK",
+	AuthInvalidResp:  "AUTH_INVALIDRESP",
+	AuthFailed:       "AUTH_FAILED",
+}
+
+func (e *Error) Error() string {
+	if e.Stat == MsgDenied {
+		switch e.RejectStat {
+		case RpcMismatch:
+			return fmt.Sprintf("rpc: RPC_MISMATCH - server supports rpc versions %d to %d", e.Low, e.High)
+		case AuthError:
+			if name, ok := authStatNames[e.AuthStat]; ok {
+				return "rpc: AUTH_ERROR - " + name
+			}
+			return fmt.Sprintf("rpc: AUTH_ERROR - unknown auth status %d", e.AuthStat)
+		}
+		return fmt.Sprintf("rpc: unknown reject status %d", e.RejectStat)
+	}
+
+	name, ok := acceptStatNames[e.AcceptStat]
+	if !ok {
+		return fmt.Sprintf("rpc: unknown accepted status error: %d", e.AcceptStat)
+	}
+
+	if e.AcceptStat == ProgMismatch {
+		return fmt.Sprintf("rpc: %s (low %d, high %d)", name, e.Low, e.High)
+	}
+
+	return "rpc: " + name
+}
+
+// Is reports whether target is an *Error with the same status. The version
+// range is not compared, and a target AuthStat of AuthOk matches any
+// authentication failure.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	if e.Stat != t.Stat {
+		return false
+	}
+
+	if e.Stat == MsgAccepted {
+		return e.AcceptStat == t.AcceptStat
+	}
+
+	if e.RejectStat != t.RejectStat {
+		return false
+	}
+
+	return e.RejectStat != AuthError || t.AuthStat == AuthOk || e.AuthStat == t.AuthStat
+}
+
+func malformed(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrMalformedReply, fmt.Sprintf(format, args...))
+}
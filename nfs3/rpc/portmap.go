@@ -1,7 +1,10 @@
 package rpc
 
 import (
-	"fmt"
+	"context"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/aobco/nfs/nfs3/xdr"
 )
@@ -11,6 +14,8 @@ const (
 	PmapProg = 100000
 	PmapVers = 2
 
+	PmapProcSet     = 1
+	PmapProcUnset   = 2
 	PmapProcGetPort = 3
 
 	IPProtoTCP = 6
@@ -39,6 +44,11 @@ type Portmapper struct {
 }
 
 func (p *Portmapper) Getport(mapping Mapping) (int, error) {
+	return p.GetportContext(context.Background(), mapping)
+}
+
+// GetportContext is like Getport, but gives up once ctx is done.
+func (p *Portmapper) GetportContext(ctx context.Context, mapping Mapping) (int, error) {
 	type getport struct {
 		Header
 		Mapping
@@ -54,7 +64,7 @@ func (p *Portmapper) Getport(mapping Mapping) (int, error) {
 		},
 		mapping,
 	}
-	res, err := p.Call(msg)
+	res, err := p.CallContext(ctx, msg)
 	if err != nil {
 		return 0, err
 	}
@@ -65,8 +75,70 @@ func (p *Portmapper) Getport(mapping Mapping) (int, error) {
 	return int(port), nil
 }
 
-func DialPortmapper(net, host string) (*Portmapper, error) {
-	client, err := DialTCP(net, nil, fmt.Sprintf("%s:%d", host, PmapPort))
+// Set registers mapping with the portmapper, so that callers asking for the
+// program find mapping.Port. It reports false when the portmapper refused,
+// typically because the program and version are already registered. Most
+// portmappers only accept Set from the local host.
+func (p *Portmapper) Set(mapping Mapping) (bool, error) {
+	return p.setUnset(PmapProcSet, mapping)
+}
+
+// Unset removes the registrations of mapping.Prog and mapping.Vers. The
+// protocol and port are ignored.
+func (p *Portmapper) Unset(mapping Mapping) (bool, error) {
+	return p.setUnset(PmapProcUnset, mapping)
+}
+
+func (p *Portmapper) setUnset(proc uint32, mapping Mapping) (bool, error) {
+	type set struct {
+		Header
+		Mapping
+	}
+	msg := &set{
+		Header{
+			Rpcvers: 2,
+			Prog:    PmapProg,
+			Vers:    PmapVers,
+			Proc:    proc,
+			Cred:    AuthNull,
+			Verf:    AuthNull,
+		},
+		mapping,
+	}
+	res, err := p.Call(msg)
+	if err != nil {
+		return false, err
+	}
+	ok, err := xdr.ReadUint32(res)
+	if err != nil {
+		return false, err
+	}
+	return ok != 0, nil
+}
+
+// NewPortmapper returns a Portmapper that talks over client to the portmapper
+// on host. host only decides which netids Resolve asks rpcbind about first:
+// the IPv6 ones when host is an IPv6 literal.
+func NewPortmapper(client *Client, host string) *Portmapper {
+	return &Portmapper{client, strings.Trim(host, "[]")}
+}
+
+// DialPortmapper connects to the portmapper on host, which may be an IPv6
+// literal. network selects the transport and may be any of the "tcp" or "udp"
+// networks.
+func DialPortmapper(network, host string) (*Portmapper, error) {
+	var (
+		client *Client
+		err    error
+	)
+
+	host = strings.Trim(host, "[]")
+	addr := net.JoinHostPort(host, strconv.Itoa(PmapPort))
+	if strings.HasPrefix(network, "udp") {
+		client, err = DialUDP(network, nil, addr)
+	} else {
+		client, err = DialTCP(network, nil, addr)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,15 @@ import (
 	"github.com/aobco/nfs/nfs3/xdr"
 )
 
+// Credential flavors, as listed in RFC 5531 and offered by MOUNT.
+const (
+	AuthFlavorNull  = 0
+	AuthFlavorUnix  = 1
+	AuthFlavorShort = 2
+	AuthFlavorDH    = 3
+	AuthFlavorGSS   = 6
+)
+
 type Auth struct {
 	Flavor uint32
 	Body   []byte
@@ -38,7 +47,7 @@ func (a AuthUnix) Auth() Auth {
 	w := new(bytes.Buffer)
 	xdr.Write(w, a)
 	return Auth{
-		1,
+		AuthFlavorUnix,
 		w.Bytes(),
 	}
 }
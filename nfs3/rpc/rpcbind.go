@@ -0,0 +1,268 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aobco/log"
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+// rpcbind versions 3 and 4 (RFC 1833) share the portmapper's program number
+// and port, but identify services by netid and universal address instead of
+// protocol number and port.
+const (
+	RpcbVers3 = 3
+	RpcbVers4 = 4
+
+	RpcbProcGetAddr     = 3
+	RpcbProcDump        = 4
+	RpcbProcGetAddrList = 11
+)
+
+// Rpcb is a single rpcbind registration.
+type Rpcb struct {
+	Prog  uint32
+	Vers  uint32
+	Netid string
+	Addr  string // universal address
+	Owner string
+}
+
+// RpcbEntry is one address returned by GETADDRLIST.
+type RpcbEntry struct {
+	Maddr     string // universal address
+	Netid     string
+	Semantics uint32
+	Protofmly string
+	Proto     string
+}
+
+func rpcbHeader(vers, proc uint32) Header {
+	return Header{
+		Rpcvers: 2,
+		Prog:    PmapProg,
+		Vers:    vers,
+		Proc:    proc,
+		Cred:    AuthNull,
+		Verf:    AuthNull,
+	}
+}
+
+// GetAddr asks rpcbind (version 3 or 4) for the universal address of the
+// given program, version and netid. An empty string means the program is not
+// registered.
+func (p *Portmapper) GetAddr(vers uint32, r Rpcb) (string, error) {
+	return p.GetAddrContext(context.Background(), vers, r)
+}
+
+// GetAddrContext is like GetAddr, but gives up once ctx is done.
+func (p *Portmapper) GetAddrContext(ctx context.Context, vers uint32, r Rpcb) (string, error) {
+	type getaddr struct {
+		Header
+		Rpcb
+	}
+
+	res, err := p.CallContext(ctx, &getaddr{rpcbHeader(vers, RpcbProcGetAddr), r})
+	if err != nil {
+		return "", err
+	}
+
+	var uaddr string
+	if err = xdr.Read(res, &uaddr); err != nil {
+		return "", err
+	}
+
+	return uaddr, nil
+}
+
+// GetAddrList returns every address the program is registered at, across all
+// transports. Only rpcbind version 4 implements it.
+func (p *Portmapper) GetAddrList(r Rpcb) ([]RpcbEntry, error) {
+	type getaddrlist struct {
+		Header
+		Rpcb
+	}
+
+	res, err := p.Call(&getaddrlist{rpcbHeader(RpcbVers4, RpcbProcGetAddrList), r})
+	if err != nil {
+		return nil, err
+	}
+
+	// rpcb_entry_list_ptr is an optional-data linked list, see readDirPlus
+	// in nfs3 for the same encoding.
+	var entries []RpcbEntry
+	for {
+		var item struct {
+			IsSet bool      `xdr:"union"`
+			Entry RpcbEntry `xdr:"unioncase=1"`
+		}
+		if err = xdr.Read(res, &item); err != nil {
+			return nil, err
+		}
+
+		if !item.IsSet {
+			break
+		}
+
+		entries = append(entries, item.Entry)
+	}
+
+	return entries, nil
+}
+
+// Dump lists every registration known to rpcbind (version 3 or 4).
+func (p *Portmapper) Dump(vers uint32) ([]Rpcb, error) {
+	res, err := p.Call(&struct{ Header }{rpcbHeader(vers, RpcbProcDump)})
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Rpcb
+	for {
+		var item struct {
+			IsSet bool `xdr:"union"`
+			Map   Rpcb `xdr:"unioncase=1"`
+		}
+		if err = xdr.Read(res, &item); err != nil {
+			return nil, err
+		}
+
+		if !item.IsSet {
+			break
+		}
+
+		list = append(list, item.Map)
+	}
+
+	return list, nil
+}
+
+// ResolveStepTimeout bounds each rpcbind version Resolve asks. Portmappers
+// that drop calls to versions they lack, rather than answer PROG_MISMATCH,
+// would otherwise hold up the GETPORT fallback for a full retransmit cycle.
+var ResolveStepTimeout = 5 * time.Second
+
+// Resolve returns the port mapping.Prog is listening on. It asks rpcbind
+// version 4, then version 3, and falls back to portmap version 2 GETPORT for
+// servers that predate rpcbind or only registered the program with portmap.
+// A port of 0 means the program is not registered.
+func (p *Portmapper) Resolve(mapping Mapping) (int, error) {
+	return p.ResolveContext(context.Background(), mapping)
+}
+
+// ResolveContext is like Resolve, but gives up once ctx is done.
+func (p *Portmapper) ResolveContext(ctx context.Context, mapping Mapping) (int, error) {
+	netids := netidsFor(p.host, mapping.Prot)
+
+	for _, vers := range []uint32{RpcbVers4, RpcbVers3} {
+		sctx, cancel := context.WithTimeout(ctx, ResolveStepTimeout)
+		port, err := p.resolveAddr(sctx, vers, mapping, netids)
+		cancel()
+
+		if err == nil {
+			if port != 0 {
+				return port, nil
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			return 0, err
+		}
+
+		log.Debugf("rpcbind v%d GETADDR failed: %s", vers, err)
+
+		// Only go on to version 3 when the server says it has it. A
+		// server without rpcbind, or one that does not answer, gets
+		// GETPORT straight away.
+		var rpcErr *Error
+		if !errors.As(err, &rpcErr) || !errors.Is(err, ErrProgMismatch) || rpcErr.High < RpcbVers3 {
+			break
+		}
+	}
+
+	return p.GetportContext(ctx, mapping)
+}
+
+func (p *Portmapper) resolveAddr(ctx context.Context, vers uint32, mapping Mapping, netids []string) (int, error) {
+	for _, netid := range netids {
+		uaddr, err := p.GetAddrContext(ctx, vers, Rpcb{
+			Prog:  mapping.Prog,
+			Vers:  mapping.Vers,
+			Netid: netid,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		if uaddr == "" {
+			continue
+		}
+
+		_, port, err := ParseUniversalAddr(uaddr)
+		if err != nil {
+			return 0, err
+		}
+
+		return port, nil
+	}
+
+	return 0, nil
+}
+
+// netidsFor returns the rpcbind netids to try for a protocol, most likely
+// first given the address family of host.
+func netidsFor(host string, prot uint32) []string {
+	netid := "tcp"
+	if prot == IPProtoUDP {
+		netid = "udp"
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		return []string{netid + "6", netid}
+	}
+
+	return []string{netid, netid + "6"}
+}
+
+// ParseUniversalAddr splits an rpcbind universal address, such as
+// "192.0.2.1.8.1" or "2001:db8::1.8.1", into its host and port. The last two
+// dotted fields are the high and low bytes of the port.
+func ParseUniversalAddr(uaddr string) (string, int, error) {
+	lo := strings.LastIndexByte(uaddr, '.')
+	if lo < 0 {
+		return "", 0, fmt.Errorf("rpc: malformed universal address %q", uaddr)
+	}
+
+	hi := strings.LastIndexByte(uaddr[:lo], '.')
+	if hi < 0 {
+		return "", 0, fmt.Errorf("rpc: malformed universal address %q", uaddr)
+	}
+
+	p1, err := strconv.ParseUint(uaddr[hi+1:lo], 10, 8)
+	if err != nil {
+		return "", 0, fmt.Errorf("rpc: malformed universal address %q: %w", uaddr, err)
+	}
+
+	p2, err := strconv.ParseUint(uaddr[lo+1:], 10, 8)
+	if err != nil {
+		return "", 0, fmt.Errorf("rpc: malformed universal address %q: %w", uaddr, err)
+	}
+
+	host := uaddr[:hi]
+	if net.ParseIP(host) == nil {
+		return "", 0, fmt.Errorf("rpc: malformed universal address %q", uaddr)
+	}
+
+	return host, int(p1<<8 | p2), nil
+}
+
+// FormatUniversalAddr is the inverse of ParseUniversalAddr.
+func FormatUniversalAddr(host string, port int) string {
+	return fmt.Sprintf("%s.%d.%d", host, port>>8&0xff, port&0xff)
+}
@@ -0,0 +1,157 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseUniversalAddr(t *testing.T) {
+	for _, tt := range []struct {
+		uaddr string
+		host  string
+		port  int
+	}{
+		{"192.0.2.1.8.1", "192.0.2.1", 2049},
+		{"0.0.0.0.0.111", "0.0.0.0", 111},
+		{"127.0.0.1.255.255", "127.0.0.1", 65535},
+		{"2001:db8::1.8.1", "2001:db8::1", 2049},
+		{"::.0.111", "::", 111},
+		{"::ffff:192.0.2.1.3.235", "::ffff:192.0.2.1", 1003},
+	} {
+		host, port, err := ParseUniversalAddr(tt.uaddr)
+		if err != nil || host != tt.host || port != tt.port {
+			t.Errorf("ParseUniversalAddr(%q) = %q, %d, %v, want %q, %d", tt.uaddr, host, port, err, tt.host, tt.port)
+			continue
+		}
+
+		if got := FormatUniversalAddr(host, port); got != tt.uaddr {
+			t.Errorf("FormatUniversalAddr(%q, %d) = %q, want %q", host, port, got, tt.uaddr)
+		}
+	}
+}
+
+func TestParseUniversalAddrErrors(t *testing.T) {
+	for _, uaddr := range []string{
+		"",
+		"192.0.2.1",
+		"192.0.2.1.8",
+		"192.0.2.1.256.1",
+		"192.0.2.1.8.-1",
+		"192.0.2.1.8.x",
+		"host.example.8.1",
+		".8.1",
+		"/var/run/rpcbind.sock",
+	} {
+		if host, port, err := ParseUniversalAddr(uaddr); err == nil {
+			t.Errorf("ParseUniversalAddr(%q) = %q, %d, want an error", uaddr, host, port)
+		}
+	}
+}
+
+func TestResolveFallsBackToGetport(t *testing.T) {
+	s := NewServer()
+	for _, vers := range []uint32{RpcbVers4, RpcbVers3} {
+		s.Register(PmapProg, vers, RpcbProcGetAddr, func(call *Call, args io.Reader) (interface{}, error) {
+			return "", nil
+		})
+	}
+	s.Register(PmapProg, PmapVers, PmapProcGetPort, func(call *Call, args io.Reader) (interface{}, error) {
+		return uint32(2049), nil
+	})
+	p := NewPortmapper(serve(t, s), "127.0.0.1")
+
+	port, err := p.Resolve(Mapping{Prog: 100003, Vers: 3, Prot: IPProtoTCP})
+	if err != nil || port != 2049 {
+		t.Errorf("Resolve = %d, %v, want 2049", port, err)
+	}
+}
+
+func TestResolveContext(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	s := NewServer()
+	for _, vers := range []uint32{RpcbVers4, RpcbVers3} {
+		s.Register(PmapProg, vers, RpcbProcGetAddr, func(call *Call, args io.Reader) (interface{}, error) {
+			<-release
+			return "", nil
+		})
+	}
+	s.Register(PmapProg, PmapVers, PmapProcGetPort, func(call *Call, args io.Reader) (interface{}, error) {
+		<-release
+		return uint32(0), nil
+	})
+	p := NewPortmapper(serve(t, s), "127.0.0.1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := p.ResolveContext(ctx, Mapping{Prog: 100003, Vers: 3, Prot: IPProtoTCP}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Resolve from a portmapper that does not answer: %v, want a deadline error", err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("Resolve took %s", d)
+	}
+}
+
+func TestResolveFallsBackQuickly(t *testing.T) {
+	getport := func(call *Call, args io.Reader) (interface{}, error) {
+		return uint32(2049), nil
+	}
+
+	// A portmapper without rpcbind answers PROG_MISMATCH.
+	s := NewServer()
+	s.Register(PmapProg, PmapVers, PmapProcGetPort, getport)
+	p := NewPortmapper(serve(t, s), "127.0.0.1")
+	if port, err := p.Resolve(Mapping{Prog: 100003, Vers: 3, Prot: IPProtoTCP}); err != nil || port != 2049 {
+		t.Errorf("Resolve from portmap only = %d, %v, want 2049", port, err)
+	}
+
+	// One that ignores calls to rpcbind gets one step's time, and no
+	// version 3 call after version 4 went unanswered.
+	defer func(d time.Duration) { ResolveStepTimeout = d }(ResolveStepTimeout)
+	ResolveStepTimeout = 50 * time.Millisecond
+
+	var (
+		mu    sync.Mutex
+		asked []uint32
+	)
+	release := make(chan struct{})
+	defer close(release)
+
+	s = NewServer()
+	for _, vers := range []uint32{RpcbVers4, RpcbVers3} {
+		vers := vers
+		s.Register(PmapProg, vers, RpcbProcGetAddr, func(call *Call, args io.Reader) (interface{}, error) {
+			mu.Lock()
+			asked = append(asked, vers)
+			mu.Unlock()
+
+			<-release
+			return "", nil
+		})
+	}
+	s.Register(PmapProg, PmapVers, PmapProcGetPort, getport)
+	p = NewPortmapper(serve(t, s), "127.0.0.1")
+
+	start := time.Now()
+	if port, err := p.Resolve(Mapping{Prog: 100003, Vers: 3, Prot: IPProtoTCP}); err != nil || port != 2049 {
+		t.Errorf("Resolve from a silent rpcbind = %d, %v, want 2049", port, err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("Resolve from a silent rpcbind took %s", d)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, vers := range asked {
+		if vers != RpcbVers4 {
+			t.Errorf("asked rpcbind version %d after version 4 did not answer", vers)
+		}
+	}
+}
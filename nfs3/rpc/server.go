@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"runtime/debug"
+	"sort"
+	"sync"
+
+	"github.com/aobco/log"
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+// Call is an incoming call as seen by a Handler.
+type Call struct {
+	Xid uint32
+	Header
+	RemoteAddr net.Addr
+}
+
+// Handler serves one procedure. It decodes its arguments from args and
+// returns the result to XDR-encode into the reply, or nil for a void result.
+// Returning an *Error such as ErrGarbageArgs or ErrSystemErr sends that status
+// instead; any other error is reported as SYSTEM_ERR.
+type Handler func(call *Call, args io.Reader) (interface{}, error)
+
+// DefaultMaxConnCalls is how many calls a Server handles at once on one
+// connection, unless its MaxConnCalls says otherwise.
+var DefaultMaxConnCalls = 64
+
+// Server dispatches ONC RPC calls received over TCP to registered handlers.
+// A handler that panics fails its call with SYSTEM_ERR.
+type Server struct {
+	// Authenticate, when set, checks the credentials of every call and
+	// returns AuthOk or the auth_stat to reject the call with.
+	Authenticate func(call *Call) uint32
+
+	// MaxConnCalls bounds the calls handled at once on each connection.
+	// Once it is reached, no more calls are read from the connection until
+	// one of them is answered. Zero means DefaultMaxConnCalls.
+	MaxConnCalls int
+
+	mu       sync.RWMutex
+	programs map[uint32]map[uint32]map[uint32]Handler
+}
+
+func NewServer() *Server {
+	return &Server{
+		programs: make(map[uint32]map[uint32]map[uint32]Handler),
+	}
+}
+
+// Register installs h for the given program, version and procedure. The NULL
+// procedure (0) of every registered version answers on its own unless a
+// handler is registered for it.
+func (s *Server) Register(prog, vers, proc uint32, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	versions, ok := s.programs[prog]
+	if !ok {
+		versions = make(map[uint32]map[uint32]Handler)
+		s.programs[prog] = versions
+	}
+
+	procs, ok := versions[vers]
+	if !ok {
+		procs = make(map[uint32]Handler)
+		versions[vers] = procs
+	}
+
+	procs[proc] = h
+}
+
+// Serve accepts connections on l and serves each of them in its own
+// goroutine. It returns when l fails to accept, e.g. because it was closed.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go s.ServeConn(conn)
+	}
+}
+
+// ServeConn serves calls from conn until it is closed. Calls are handled
+// concurrently, up to MaxConnCalls at a time, and their replies may go out in
+// any order.
+func (s *Server) ServeConn(conn net.Conn) {
+	defer conn.Close()
+
+	max := s.MaxConnCalls
+	if max <= 0 {
+		max = DefaultMaxConnCalls
+	}
+	slots := make(chan struct{}, max)
+
+	t := &tcpTransport{
+		r:             bufio.NewReader(conn),
+		wc:            conn,
+		maxRecordSize: int64(DefaultMaxRecordSize),
+	}
+
+	for {
+		msg, err := t.recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				log.Debugf("rpc server: %s: %s", conn.RemoteAddr(), err)
+			}
+			return
+		}
+
+		slots <- struct{}{}
+		go func() {
+			defer func() { <-slots }()
+
+			reply, ok := s.handle(conn.RemoteAddr(), msg)
+			if !ok {
+				return
+			}
+
+			if _, err := t.Write(reply); err != nil {
+				log.Debugf("rpc server: %s: writing reply: %s", conn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+// handle decodes a call and returns the encoded reply. Messages that are not
+// calls get no reply.
+func (s *Server) handle(addr net.Addr, msg io.Reader) (reply []byte, ok bool) {
+	var head struct {
+		Xid     uint32
+		Msgtype uint32
+	}
+	if err := xdr.Read(msg, &head); err != nil || head.Msgtype != 0 {
+		log.Debugf("rpc server: %s: dropping message that is not a call", addr)
+		return nil, false
+	}
+
+	call := &Call{Xid: head.Xid, RemoteAddr: addr}
+	defer func() {
+		if v := recover(); v != nil {
+			log.Errorf("rpc server: prog %d proc %d: panic: %v\n%s", call.Prog, call.Proc, v, debug.Stack())
+			reply, ok = encodeReply(head.Xid, ErrSystemErr, nil), true
+		}
+	}()
+
+	if err := xdr.Read(msg, &call.Header); err != nil {
+		return encodeReply(head.Xid, ErrGarbageArgs, nil), true
+	}
+
+	if call.Rpcvers != 2 {
+		return encodeReply(head.Xid, &Error{Stat: MsgDenied, RejectStat: RpcMismatch, Low: 2, High: 2}, nil), true
+	}
+
+	if s.Authenticate != nil {
+		if stat := s.Authenticate(call); stat != AuthOk {
+			return encodeReply(head.Xid, &Error{Stat: MsgDenied, RejectStat: AuthError, AuthStat: stat}, nil), true
+		}
+	}
+
+	h, rpcErr := s.lookup(call.Prog, call.Vers, call.Proc)
+	if rpcErr != nil {
+		return encodeReply(head.Xid, rpcErr, nil), true
+	}
+
+	if h == nil {
+		// NULL procedure
+		return encodeReply(head.Xid, nil, nil), true
+	}
+
+	res, err := h(call, msg)
+	if err != nil {
+		if !errors.As(err, &rpcErr) {
+			log.Debugf("rpc server: prog %d proc %d: %s", call.Prog, call.Proc, err)
+			rpcErr = ErrSystemErr
+		}
+		return encodeReply(head.Xid, rpcErr, nil), true
+	}
+
+	return encodeReply(head.Xid, nil, res), true
+}
+
+// lookup finds the handler for a procedure, or the error to reply with. A nil
+// handler without an error stands for the built-in NULL procedure.
+func (s *Server) lookup(prog, vers, proc uint32) (Handler, *Error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	versions, ok := s.programs[prog]
+	if !ok {
+		return nil, ErrProgUnavail
+	}
+
+	procs, ok := versions[vers]
+	if !ok {
+		var supported []int
+		for v := range versions {
+			supported = append(supported, int(v))
+		}
+		sort.Ints(supported)
+
+		return nil, &Error{
+			Stat:       MsgAccepted,
+			AcceptStat: ProgMismatch,
+			Low:        uint32(supported[0]),
+			High:       uint32(supported[len(supported)-1]),
+		}
+	}
+
+	h, ok := procs[proc]
+	if !ok && proc != 0 {
+		return nil, ErrProcUnavail
+	}
+
+	return h, nil
+}
+
+// encodeReply builds a reply message: a successful one carrying res when
+// rpcErr is nil, otherwise an accepted or denied reply with rpcErr's status.
+func encodeReply(xid uint32, rpcErr *Error, res interface{}) []byte {
+	w := new(bytes.Buffer)
+
+	words := []uint32{xid, 1}
+	switch {
+	case rpcErr == nil:
+		words = append(words, MsgAccepted, 0, 0, Success)
+	case rpcErr.Stat == MsgAccepted:
+		words = append(words, MsgAccepted, 0, 0, rpcErr.AcceptStat)
+		if rpcErr.AcceptStat == ProgMismatch {
+			words = append(words, rpcErr.Low, rpcErr.High)
+		}
+	default:
+		words = append(words, MsgDenied, rpcErr.RejectStat)
+		if rpcErr.RejectStat == RpcMismatch {
+			words = append(words, rpcErr.Low, rpcErr.High)
+		} else {
+			words = append(words, rpcErr.AuthStat)
+		}
+	}
+
+	for _, word := range words {
+		xdr.Write(w, word)
+	}
+
+	if rpcErr == nil && res != nil {
+		if err := xdr.Write(w, res); err != nil {
+			log.Errorf("rpc server: encoding result for xid %x: %s", xid, err)
+			return encodeReply(xid, ErrSystemErr, nil)
+		}
+	}
+
+	return w.Bytes()
+}
@@ -0,0 +1,140 @@
+package rpc
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aobco/log"
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+func init() {
+	// The logger sets itself up on first use without synchronization, and
+	// tests log from several goroutines at once.
+	log.Default()
+}
+
+const (
+	testProg = 0x20000000
+	testVers = 1
+)
+
+// serve serves s on a loopback listener and returns a client connected to
+// it.
+func serve(t *testing.T, s *Server) *Client {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(l)
+
+	c, err := DialTCP("tcp", nil, l.Addr().String())
+	if err != nil {
+		l.Close()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		c.Close()
+		l.Close()
+	})
+
+	return c
+}
+
+type testCall struct {
+	Header
+	Arg uint32
+}
+
+func call(c *Client, proc, arg uint32) (uint32, error) {
+	res, err := c.Call(&testCall{
+		Header: Header{Rpcvers: 2, Prog: testProg, Vers: testVers, Proc: proc},
+		Arg:    arg,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var v uint32
+	err = xdr.Read(res, &v)
+	return v, err
+}
+
+func TestServerRecoversFromPanic(t *testing.T) {
+	s := NewServer()
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		var arg uint32
+		if err := xdr.Read(args, &arg); err != nil {
+			return nil, ErrGarbageArgs
+		}
+		if arg == 0 {
+			panic("division by zero")
+		}
+		return 100 / arg, nil
+	})
+	c := serve(t, s)
+
+	if _, err := call(c, 1, 0); !errors.Is(err, ErrSystemErr) {
+		t.Errorf("panicking handler: got %v, want SYSTEM_ERR", err)
+	}
+	if v, err := call(c, 1, 4); err != nil || v != 25 {
+		t.Errorf("after the panic: got %d, %v, want 25", v, err)
+	}
+}
+
+func TestServerLimitsConnCalls(t *testing.T) {
+	const limit = 2
+
+	var (
+		mu      sync.Mutex
+		active  int
+		most    int
+		release = make(chan struct{})
+	)
+	s := NewServer()
+	s.MaxConnCalls = limit
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		mu.Lock()
+		active++
+		if active > most {
+			most = active
+		}
+		mu.Unlock()
+
+		<-release
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return uint32(0), nil
+	})
+	c := serve(t, s)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3*limit; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := call(c, 1, 0); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	// Give the calls over the limit a chance to start, wrongly.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if most != limit {
+		t.Errorf("%d calls handled at once, want %d", most, limit)
+	}
+}
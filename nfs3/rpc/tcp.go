@@ -3,37 +3,70 @@ package rpc
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// Record marking (RFC 5531 section 11): every fragment is preceded by a four
+// byte header holding its length, with the high bit set on the last fragment
+// of a record.
+const (
+	lastFragment  = 0x80000000
+	fragmentSizes = 0x7fffffff
+)
+
+// DefaultMaxRecordSize caps the size of a reassembled record. A reply larger
+// than this is treated as a protocol error rather than allocated.
+var DefaultMaxRecordSize = 16 << 20
+
+// DefaultMaxFragmentSize is the largest fragment sent; longer messages are
+// split into several fragments. Zero sends every message as one fragment.
+var DefaultMaxFragmentSize = 0
+
 type tcpTransport struct {
-	r       io.Reader
-	wc      net.Conn
-	timeout time.Duration
+	r  io.Reader
+	wc net.Conn
+
+	// accessed atomically, they may change while the reader is blocked or
+	// calls are being sent
+	timeout         int64 // time.Duration
+	maxRecordSize   int64
+	maxFragmentSize int64
 
-	rlock, wlock sync.Mutex
+	wlock sync.Mutex
+}
+
+// recvStream returns a reader over the next record that reads it off the
+// connection as it is consumed, so a reply can be decoded without buffering
+// it first. The record must be read to EOF before the next one is asked for.
+// Only one goroutine may receive from a transport; no read deadline is set
+// here, per-call timeouts are enforced by the waiting caller instead.
+func (t *tcpTransport) recvStream() (io.Reader, error) {
+	rr := &recordReader{
+		r:   t.r,
+		max: atomic.LoadInt64(&t.maxRecordSize),
+	}
+	if err := rr.nextFragment(); err != nil {
+		return nil, err
+	}
+
+	return rr, nil
 }
 
 // Get the response from the conn, buffer the contents, and return a reader to
 // it.
 func (t *tcpTransport) recv() (io.ReadSeeker, error) {
-	t.rlock.Lock()
-	defer t.rlock.Unlock()
-	if t.timeout != 0 {
-		deadline := time.Now().Add(t.timeout)
-		t.wc.SetReadDeadline(deadline)
-	}
-
-	var hdr uint32
-	if err := binary.Read(t.r, binary.BigEndian, &hdr); err != nil {
+	rec, err := t.recvStream()
+	if err != nil {
 		return nil, err
 	}
 
-	buf := make([]byte, hdr&0x7fffffff)
-	if _, err := io.ReadFull(t.r, buf); err != nil {
+	buf, err := readRest(rec, nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -41,19 +74,83 @@ func (t *tcpTransport) recv() (io.ReadSeeker, error) {
 }
 
 func (t *tcpTransport) Write(buf []byte) (int, error) {
+	return t.writev(net.Buffers{buf})
+}
+
+// writev sends bufs as one record, split into fragments if needed. Over TCP
+// the record markers and bufs go out in a single vectored write; other
+// connections, such as TLS, get them as one flat buffer instead.
+func (t *tcpTransport) writev(bufs net.Buffers) (int, error) {
 	t.wlock.Lock()
 	defer t.wlock.Unlock()
 
-	var hdr uint32 = uint32(len(buf)) | 0x80000000
-	b := make([]byte, 4)
-	binary.BigEndian.PutUint32(b, hdr)
-	if t.timeout != 0 {
-		deadline := time.Now().Add(t.timeout)
+	frag := int(atomic.LoadInt64(&t.maxFragmentSize))
+	if frag <= 0 || frag > fragmentSizes {
+		frag = fragmentSizes
+	}
+
+	total := buffersLen(bufs)
+	nfrag := (total + frag - 1) / frag
+	if nfrag == 0 {
+		nfrag = 1
+	}
+
+	marks := make([]byte, 4*nfrag)
+	out := make(net.Buffers, 0, len(bufs)+nfrag)
+	for f, i, off := 0, 0, 0; f < nfrag; f++ {
+		n := total - f*frag
+		if n > frag {
+			n = frag
+		}
+
+		hdr := uint32(n)
+		if f == nfrag-1 {
+			hdr |= lastFragment
+		}
+
+		mark := marks[4*f : 4*f+4]
+		binary.BigEndian.PutUint32(mark, hdr)
+		out = append(out, mark)
+
+		for n > 0 {
+			b := bufs[i][off:]
+			if len(b) > n {
+				b = b[:n]
+			}
+			if len(b) > 0 {
+				out = append(out, b)
+			}
+
+			n -= len(b)
+			if off += len(b); off == len(bufs[i]) {
+				i, off = i+1, 0
+			}
+		}
+	}
+
+	if timeout := time.Duration(atomic.LoadInt64(&t.timeout)); timeout != 0 {
+		deadline := time.Now().Add(timeout)
 		t.wc.SetWriteDeadline(deadline)
 	}
-	n, err := t.wc.Write(append(b, buf...))
 
-	return n, err
+	if _, ok := t.wc.(*net.TCPConn); !ok {
+		flat := make([]byte, 0, total+len(marks))
+		for _, b := range out {
+			flat = append(flat, b...)
+		}
+		return t.wc.Write(flat)
+	}
+
+	n, err := out.WriteTo(t.wc)
+	return int(n), err
+}
+
+func (t *tcpTransport) LocalAddr() net.Addr {
+	return t.wc.LocalAddr()
+}
+
+func (t *tcpTransport) RemoteAddr() net.Addr {
+	return t.wc.RemoteAddr()
 }
 
 func (t *tcpTransport) Close() error {
@@ -61,9 +158,116 @@ func (t *tcpTransport) Close() error {
 }
 
 func (t *tcpTransport) SetTimeout(d time.Duration) {
-	t.timeout = d
+	atomic.StoreInt64(&t.timeout, int64(d))
 	if d == 0 {
 		var zeroTime time.Time
 		t.wc.SetDeadline(zeroTime)
 	}
 }
+
+// recordReader reads one record off a record-marked stream, crossing
+// fragment boundaries as it goes.
+type recordReader struct {
+	r   io.Reader
+	max int64
+
+	size   int64 // bytes announced by the fragment headers read so far
+	remain int   // bytes left in the current fragment
+	last   bool
+
+	// err is set once the connection fails; it sticks, since the stream is
+	// out of sync from then on.
+	err error
+}
+
+func (rr *recordReader) nextFragment() error {
+	var hdr [4]byte
+	if _, err := io.ReadFull(rr.r, hdr[:]); err != nil {
+		return err
+	}
+
+	h := binary.BigEndian.Uint32(hdr[:])
+	rr.remain = int(h & fragmentSizes)
+	rr.last = h&lastFragment != 0
+
+	rr.size += int64(rr.remain)
+	if rr.max > 0 && rr.size > rr.max {
+		return fmt.Errorf("rpc: record exceeds maximum size of %d bytes", rr.max)
+	}
+
+	return nil
+}
+
+// Read returns io.EOF at the end of the record. Any other error means the
+// connection is unusable.
+func (rr *recordReader) Read(b []byte) (int, error) {
+	if rr.err != nil {
+		return 0, rr.err
+	}
+
+	for rr.remain == 0 {
+		if rr.last {
+			return 0, io.EOF
+		}
+
+		if err := rr.nextFragment(); err != nil {
+			rr.err = truncated(err)
+			return 0, rr.err
+		}
+	}
+
+	if len(b) > rr.remain {
+		b = b[:rr.remain]
+	}
+
+	n, err := rr.r.Read(b)
+	rr.remain -= n
+	if err != nil {
+		rr.err = truncated(err)
+	}
+
+	return n, rr.err
+}
+
+// truncated tells a connection that ends inside a record apart from the end
+// of the record itself.
+func truncated(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return fmt.Errorf("rpc: connection closed within a record: %w", io.ErrUnexpectedEOF)
+	}
+
+	return err
+}
+
+// readRest returns prefix followed by whatever is left of rec.
+func readRest(rec io.Reader, prefix []byte) ([]byte, error) {
+	size := -1
+	switch r := rec.(type) {
+	case *bytes.Reader:
+		size = r.Len()
+	case *recordReader:
+		if r.last {
+			size = r.remain
+		}
+	}
+
+	if size >= 0 {
+		buf := make([]byte, len(prefix)+size)
+		copy(buf, prefix)
+		_, err := io.ReadFull(rec, buf[len(prefix):])
+		return buf, err
+	}
+
+	w := bytes.NewBuffer(append([]byte(nil), prefix...))
+	_, err := w.ReadFrom(rec)
+	return w.Bytes(), err
+}
+
+func buffersLen(bufs net.Buffers) int {
+	n := 0
+	for _, b := range bufs {
+		n += len(b)
+	}
+
+	return n
+}
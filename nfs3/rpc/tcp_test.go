@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"testing/iotest"
+
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+// fragments record-marks each of frags as a fragment, the last one closing
+// the record.
+func fragments(frags ...string) []byte {
+	var b []byte
+	for i, f := range frags {
+		hdr := uint32(len(f))
+		if i == len(frags)-1 {
+			hdr |= lastFragment
+		}
+		b = xdr.AppendUint32(b, hdr)
+		b = append(b, f...)
+	}
+	return b
+}
+
+func TestRecordReassembly(t *testing.T) {
+	stream := append(fragments("hel", "", "lo wor", "ld"), fragments("x")...)
+	tr := &tcpTransport{r: iotest.OneByteReader(bytes.NewReader(stream))}
+
+	for _, want := range []string{"hello world", "x"} {
+		rec, err := tr.recv()
+		if err != nil {
+			t.Fatalf("recv: %s", err)
+		}
+		if got, _ := io.ReadAll(rec); string(got) != want {
+			t.Errorf("got record %q, want %q", got, want)
+		}
+	}
+
+	if _, err := tr.recv(); err != io.EOF {
+		t.Errorf("recv at the end of the stream: %v, want io.EOF", err)
+	}
+}
+
+func TestRecordStream(t *testing.T) {
+	tr := &tcpTransport{r: bytes.NewReader(fragments("abcd", "efgh", "ij"))}
+
+	rec, err := tr.recvStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(rec, buf); err != nil || string(buf) != "abc" {
+		t.Errorf("start of the record: %q, %v", buf, err)
+	}
+	if rest, err := readRest(rec, buf[:1]); err != nil || string(rest) != "adefghij" {
+		t.Errorf("rest of the record: %q, %v", rest, err)
+	}
+}
+
+func TestRecordErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		stream []byte
+		max    int64
+		want   error
+	}{
+		{"truncated fragment", fragments("hello")[:6], 0, io.ErrUnexpectedEOF},
+		{"missing last fragment", fragments("hello", "world")[:9], 0, io.ErrUnexpectedEOF},
+		{"truncated header", fragments("hello", "world")[:11], 0, io.ErrUnexpectedEOF},
+		{"too large", fragments("hello", "world"), 8, nil},
+	} {
+		tr := &tcpTransport{r: bytes.NewReader(tt.stream), maxRecordSize: tt.max}
+
+		_, err := tr.recv()
+		if err == nil || (tt.want != nil && !errors.Is(err, tt.want)) {
+			t.Errorf("%s: recv returned %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+// bufConn is a connection writing into a buffer.
+type bufConn struct {
+	net.Conn
+	buf bytes.Buffer
+}
+
+func (c *bufConn) Write(b []byte) (int, error) { return c.buf.Write(b) }
+
+func TestWriteFragments(t *testing.T) {
+	for _, tt := range []struct {
+		max  int64
+		want []byte
+	}{
+		{0, fragments("hello world")},
+		{4, fragments("hell", "o wo", "rld")},
+		{11, fragments("hello world")},
+		{100, fragments("hello world")},
+	} {
+		conn := &bufConn{}
+		tr := &tcpTransport{wc: conn, maxFragmentSize: tt.max}
+
+		if _, err := tr.writev(net.Buffers{[]byte("hel"), nil, []byte("lo"), []byte(" world")}); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(conn.buf.Bytes(), tt.want) {
+			t.Errorf("fragments of at most %d bytes: % x, want % x", tt.max, conn.buf.Bytes(), tt.want)
+		}
+	}
+}
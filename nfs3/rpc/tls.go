@@ -0,0 +1,168 @@
+package rpc
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+// AuthFlavorTLS is the credential flavor of the NULL call that asks a server
+// to switch the connection to TLS (RFC 9289).
+const AuthFlavorTLS = 7
+
+// startTLSVerifier is the verifier body a server answers the AUTH_TLS probe
+// with when it supports RPC-over-TLS.
+const startTLSVerifier = "STARTTLS"
+
+// ErrTLSUnsupported is returned by StartTLS when the server does not offer
+// RPC-over-TLS.
+var ErrTLSUnsupported = errors.New("rpc: server does not support RPC-over-TLS")
+
+// DialTLS is like DialTCP, but upgrades the connection to TLS with StartTLS
+// before returning the Client. prog and vers name the service the NULL probe
+// is sent to.
+func DialTLS(network string, ldr *net.TCPAddr, addr string, prog, vers uint32, config *tls.Config) (*Client, error) {
+	a, err := net.ResolveTCPAddr(network, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.DialTCP(a.Network(), ldr, a)
+	if err != nil {
+		return nil, err
+	}
+
+	tconn, err := StartTLS(conn, prog, vers, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return NewClient(tconn), nil
+}
+
+// StartTLS probes conn with an AUTH_TLS NULL call and, if the server answers
+// with the STARTTLS verifier, performs the TLS handshake on it (RFC 9289).
+// The returned connection can be passed to NewClient. config may carry client
+// certificates and its own root CAs; the "sunrpc" ALPN protocol and the server
+// name are filled in when missing.
+func StartTLS(conn net.Conn, prog, vers uint32, config *tls.Config) (*tls.Conn, error) {
+	if config == nil {
+		config = &tls.Config{}
+	}
+	config = config.Clone()
+
+	if len(config.NextProtos) == 0 {
+		config.NextProtos = []string{"sunrpc"}
+	}
+
+	if config.ServerName == "" && !config.InsecureSkipVerify {
+		if host, _, err := net.SplitHostPort(conn.RemoteAddr().String()); err == nil {
+			config.ServerName = host
+		}
+	}
+
+	if DefaultReadTimeout != 0 {
+		conn.SetDeadline(time.Now().Add(DefaultReadTimeout))
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	if err := probeTLS(conn, prog, vers); err != nil {
+		return nil, err
+	}
+
+	tconn := tls.Client(conn, config)
+	if err := tconn.Handshake(); err != nil {
+		return nil, err
+	}
+
+	return tconn, nil
+}
+
+// probeTLS sends the AUTH_TLS NULL call and checks the reply. It runs before a
+// Client's reader goroutine exists, so it reads the reply directly.
+func probeTLS(conn net.Conn, prog, vers uint32) error {
+	msg := &message{
+		Xid: atomic.AddUint32(&xid, 1),
+		Body: &Header{
+			Rpcvers: 2,
+			Prog:    prog,
+			Vers:    vers,
+			Proc:    0,
+			Cred:    Auth{Flavor: AuthFlavorTLS},
+			Verf:    AuthNull,
+		},
+	}
+
+	w := new(bytes.Buffer)
+	if err := xdr.Write(w, msg); err != nil {
+		return err
+	}
+
+	// read the conn unbuffered, nothing past the reply may be consumed
+	t := &tcpTransport{
+		r:             conn,
+		wc:            conn,
+		maxRecordSize: 4096,
+	}
+	if _, err := t.Write(w.Bytes()); err != nil {
+		return err
+	}
+
+	res, err := t.recv()
+	if err != nil {
+		return err
+	}
+
+	var reply struct {
+		Xid     uint32
+		Msgtype uint32
+		Stat    uint32
+	}
+	if err = xdr.Read(res, &reply); err != nil {
+		return malformed("reading STARTTLS reply: %s", err)
+	}
+
+	if reply.Xid != msg.Xid || reply.Msgtype != 1 {
+		return malformed("unexpected STARTTLS reply: xid %x, type %d", reply.Xid, reply.Msgtype)
+	}
+
+	if reply.Stat == MsgDenied {
+		rejectStatus, err := xdr.ReadUint32(res)
+		if err != nil {
+			return malformed("reading reject status: %s", err)
+		}
+
+		rpcErr := &Error{Stat: MsgDenied, RejectStat: rejectStatus}
+		if rejectStatus == AuthError {
+			rpcErr.AuthStat, _ = xdr.ReadUint32(res)
+		}
+		return fmt.Errorf("%w: %s", ErrTLSUnsupported, rpcErr)
+	}
+
+	var verf Auth
+	if err = xdr.Read(res, &verf); err != nil {
+		return malformed("reading STARTTLS verifier: %s", err)
+	}
+
+	acceptStatus, err := xdr.ReadUint32(res)
+	if err != nil {
+		return malformed("reading accept status: %s", err)
+	}
+
+	if acceptStatus != Success {
+		return fmt.Errorf("%w: %s", ErrTLSUnsupported, &Error{Stat: MsgAccepted, AcceptStat: acceptStatus})
+	}
+
+	if string(verf.Body) != startTLSVerifier {
+		return ErrTLSUnsupported
+	}
+
+	return nil
+}
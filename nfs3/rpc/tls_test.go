@@ -0,0 +1,158 @@
+package rpc
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+// testCert returns a certificate for 127.0.0.1 and a pool trusting it.
+func testCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+// serveTLS answers the AUTH_TLS probe on the first connection to a loopback
+// listener, then serves s over TLS on it. It returns the listener's address
+// and the state of the handshake once it is done.
+func serveTLS(t *testing.T, s *Server, cert tls.Certificate) (string, <-chan tls.ConnectionState) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	state := make(chan tls.ConnectionState, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tr := &tcpTransport{r: conn, wc: conn, maxRecordSize: 4096}
+		msg, err := tr.recv()
+		if err != nil {
+			t.Errorf("reading the probe: %s", err)
+			return
+		}
+		var probe struct {
+			Xid     uint32
+			Msgtype uint32
+			Header
+		}
+		if err := xdr.Read(msg, &probe); err != nil || probe.Cred.Flavor != AuthFlavorTLS || probe.Proc != 0 {
+			t.Errorf("probe: %+v, %v, want a NULL call with AUTH_TLS", probe, err)
+			return
+		}
+
+		reply := struct {
+			Xid, Msgtype, Stat uint32
+			Verf               Auth
+			AcceptStat         uint32
+		}{probe.Xid, 1, MsgAccepted, Auth{Body: []byte(startTLSVerifier)}, Success}
+		var b bytes.Buffer
+		if err := xdr.Write(&b, &reply); err != nil {
+			t.Error(err)
+			return
+		}
+		if _, err := tr.Write(b.Bytes()); err != nil {
+			t.Error(err)
+			return
+		}
+
+		tconn := tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{cert}, NextProtos: []string{"sunrpc"}})
+		if err := tconn.Handshake(); err != nil {
+			t.Errorf("handshake: %s", err)
+			return
+		}
+		state <- tconn.ConnectionState()
+		s.ServeConn(tconn)
+	}()
+
+	return l.Addr().String(), state
+}
+
+// doubler returns a server whose procedure 1 answers twice its argument.
+func doubler() *Server {
+	s := NewServer()
+	s.Register(testProg, testVers, 1, func(call *Call, args io.Reader) (interface{}, error) {
+		var arg uint32
+		if err := xdr.Read(args, &arg); err != nil {
+			return nil, ErrGarbageArgs
+		}
+		return 2 * arg, nil
+	})
+
+	return s
+}
+
+func TestDialTLS(t *testing.T) {
+	cert, pool := testCert(t)
+	addr, state := serveTLS(t, doubler(), cert)
+
+	c, err := DialTLS("tcp", nil, addr, testProg, testVers, &tls.Config{RootCAs: pool})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if v, err := call(c, 1, 21); err != nil || v != 42 {
+		t.Errorf("call over TLS: got %d, %v, want 42", v, err)
+	}
+	if st := <-state; st.NegotiatedProtocol != "sunrpc" {
+		t.Errorf("negotiated %q, want sunrpc", st.NegotiatedProtocol)
+	}
+}
+
+func TestDialTLSUnsupported(t *testing.T) {
+	// A server without RPC-over-TLS answers the probe like any NULL call.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go doubler().Serve(l)
+
+	_, pool := testCert(t)
+	if _, err := DialTLS("tcp", nil, l.Addr().String(), testProg, testVers, &tls.Config{RootCAs: pool}); !errors.Is(err, ErrTLSUnsupported) {
+		t.Errorf("got %v, want ErrTLSUnsupported", err)
+	}
+}
@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/aobco/log"
+)
+
+// maxDatagramSize is the largest reply a UDP transport accepts.
+const maxDatagramSize = 65536
+
+// udpTransport sends every message as a single datagram. There is no record
+// marking on datagram transports; the datagram boundary frames the message.
+type udpTransport struct {
+	conn    *net.UDPConn
+	timeout int64 // time.Duration, accessed atomically
+}
+
+// recv returns the next datagram. An ICMP port unreachable, reported by Read
+// as ECONNREFUSED, is skipped: it only means an earlier datagram was not
+// delivered, e.g. while the server was restarting, and the call is
+// retransmitted anyway.
+func (t *udpTransport) recv() (io.ReadSeeker, error) {
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, err := t.conn.Read(buf)
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			log.Debugf("rpc: %s unreachable: %s", t.conn.RemoteAddr(), err)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return bytes.NewReader(buf[:n]), nil
+	}
+}
+
+func (t *udpTransport) recvStream() (io.Reader, error) {
+	return t.recv()
+}
+
+// writev sends bufs as a single datagram.
+func (t *udpTransport) writev(bufs net.Buffers) (int, error) {
+	buf := make([]byte, 0, buffersLen(bufs))
+	for _, b := range bufs {
+		buf = append(buf, b...)
+	}
+
+	return t.Write(buf)
+}
+
+func (t *udpTransport) Write(buf []byte) (int, error) {
+	if timeout := time.Duration(atomic.LoadInt64(&t.timeout)); timeout != 0 {
+		deadline := time.Now().Add(timeout)
+		t.conn.SetWriteDeadline(deadline)
+	}
+
+	return t.conn.Write(buf)
+}
+
+func (t *udpTransport) LocalAddr() net.Addr {
+	return t.conn.LocalAddr()
+}
+
+func (t *udpTransport) RemoteAddr() net.Addr {
+	return t.conn.RemoteAddr()
+}
+
+func (t *udpTransport) Close() error {
+	return t.conn.Close()
+}
+
+func (t *udpTransport) SetTimeout(d time.Duration) {
+	atomic.StoreInt64(&t.timeout, int64(d))
+	if d == 0 {
+		var zeroTime time.Time
+		t.conn.SetDeadline(zeroTime)
+	}
+}
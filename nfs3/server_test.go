@@ -0,0 +1,168 @@
+package nfs3
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aobco/log"
+	"github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/nfs3/lru"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+func init() {
+	// The logger sets itself up on first use without synchronization, and
+	// tests log from several goroutines at once.
+	log.Default()
+}
+
+// newTestTarget returns a Target whose root has handle rootFH, talking to s
+// over loopback TCP.
+func newTestTarget(t *testing.T, s *rpc.Server) *Target {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(l)
+
+	client, err := rpc.DialTCP("tcp", nil, l.Addr().String())
+	if err != nil {
+		l.Close()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+		l.Close()
+	})
+
+	return &Target{
+		Client:  client,
+		conns:   []*rpc.Client{client},
+		Auth:    rpc.AuthNull,
+		fh:      rootFH,
+		fsinfo:  &FSInfo{RTPref: 1 << 16, WTPref: 1 << 16},
+		fhCache: lru.NewLRUCache(100),
+		attrs:   newAttrCache(&DefaultAttrCacheOptions),
+	}
+}
+
+var rootFH = []byte("root")
+
+// decodeArgs decodes the arguments of a call into v.
+func decodeArgs(t *testing.T, args io.Reader, v internal.XdrType) {
+	t.Helper()
+
+	if err := xdrMarshal(internal.XdrIn{In: args}, v); err != nil {
+		t.Errorf("decoding %T: %s", v, err)
+	}
+}
+
+// reply encodes res for a Handler to return. The rpc.Server encodes results
+// with reflection, which writes a byte array as it is.
+func reply(res internal.XdrType) interface{} {
+	var b bytes.Buffer
+	if err := xdrMarshal(internal.XdrOut{Out: &b}, res); err != nil {
+		panic(err)
+	}
+
+	v := reflect.New(reflect.ArrayOf(b.Len(), reflect.TypeOf(byte(0)))).Elem()
+	reflect.Copy(v, reflect.ValueOf(b.Bytes()))
+	return v.Interface()
+}
+
+// fattr returns attributes of a file of type ftype, last changed now.
+func fattr(ftype internal.Ftype3) internal.Fattr3 {
+	now := internal.Nfstime3{Seconds: uint32(time.Now().Unix())}
+	return internal.Fattr3{Type: ftype, Mode: 0755, Mtime: now, Ctime: now}
+}
+
+// postOpAttr returns fattr(ftype) as the attributes a reply carries.
+func postOpAttr(ftype internal.Ftype3) internal.Post_op_attr {
+	var a internal.Post_op_attr
+	a.Attributes_follow = true
+	*a.Attributes() = fattr(ftype)
+	return a
+}
+
+// postOpFH returns fh as the handle a reply carries.
+func postOpFH(fh []byte) internal.Post_op_fh3 {
+	var h internal.Post_op_fh3
+	h.Handle_follows = true
+	h.Handle().Data = fh
+	return h
+}
+
+// nfsServer serves NFS procedures, counting the calls to each. Handlers run
+// with mu held, so tests may share state with them under mu.
+type nfsServer struct {
+	*rpc.Server
+
+	mu    sync.Mutex
+	calls map[uint64]int
+}
+
+func newNFSServer() *nfsServer {
+	return &nfsServer{Server: rpc.NewServer(), calls: make(map[uint64]int)}
+}
+
+func (s *nfsServer) handle(proc uint32, h func(args io.Reader) internal.XdrType) {
+	s.handleProg(Nfs3Prog, Nfs3Vers, proc, h)
+}
+
+// handleProc is like handle, for the procedure of another program, such as
+// NFSACL, that p describes.
+func (s *nfsServer) handleProc(p internal.XdrProc, h func(args io.Reader) internal.XdrType) {
+	s.handleProg(p.Prog(), p.Vers(), p.Proc(), h)
+}
+
+func (s *nfsServer) handleProg(prog, vers, proc uint32, h func(args io.Reader) internal.XdrType) {
+	s.Register(prog, vers, proc, func(call *rpc.Call, args io.Reader) (interface{}, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.calls[uint64(prog)<<32|uint64(proc)]++
+		return reply(h(args)), nil
+	})
+}
+
+func (s *nfsServer) count(proc uint32) int {
+	return s.countProg(Nfs3Prog, proc)
+}
+
+func (s *nfsServer) countProc(p internal.XdrProc) int {
+	return s.countProg(p.Prog(), p.Proc())
+}
+
+func (s *nfsServer) countProg(prog, proc uint32) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls[uint64(prog)<<32|uint64(proc)]
+}
+
+// handleLookup answers LOOKUP with the handle dir/name, for a directory if
+// name is among dirs and a regular file otherwise.
+func (s *nfsServer) handleLookup(t *testing.T, dirs ...string) {
+	s.handle(NFSProc3Lookup, func(args io.Reader) internal.XdrType {
+		var a internal.LOOKUP3args
+		decodeArgs(t, args, &a)
+
+		ftype := internal.NF3REG
+		for _, d := range dirs {
+			if a.What.Name == d {
+				ftype = internal.NF3DIR
+			}
+		}
+		res := &internal.LOOKUP3res{}
+		res.Resok().Object.Data = append(append(a.What.Dir.Data, '/'), a.What.Name...)
+		res.Resok().Obj_attributes = postOpAttr(ftype)
+		return res
+	})
+}
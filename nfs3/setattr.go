@@ -0,0 +1,127 @@
+package nfs3
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"time"
+)
+
+// Chmod sets the permission bits of path to those of mode, along with its
+// setuid, setgid and sticky bits.
+func (v *Target) Chmod(path string, mode os.FileMode) error {
+	return v.Setattr(path, chmodAttr(mode))
+}
+
+// Chown sets the owner and group of path. An id of -1 is left unchanged, as
+// with os.Chown.
+func (v *Target) Chown(path string, uid, gid int) error {
+	var sattr Sattr3
+	if uid != -1 {
+		sattr.UID = SetUID{SetIt: true, UID: uint32(uid)}
+	}
+	if gid != -1 {
+		sattr.GID = SetUID{SetIt: true, UID: uint32(gid)}
+	}
+
+	return v.Setattr(path, sattr)
+}
+
+// Truncate changes the size of path, cutting it short or extending it with
+// zeros.
+func (v *Target) Truncate(path string, size int64) error {
+	if size < 0 {
+		return os.ErrInvalid
+	}
+
+	return v.Setattr(path, truncateAttr(size))
+}
+
+// Chtimes sets the access and modification times of path. A zero time is
+// left unchanged. NFSv3 times are unsigned 32-bit seconds, so times before
+// 1970 or after 2106 are refused.
+func (v *Target) Chtimes(path string, atime, mtime time.Time) error {
+	a, err := setTime(atime)
+	if err != nil {
+		return err
+	}
+	m, err := setTime(mtime)
+	if err != nil {
+		return err
+	}
+
+	return v.Setattr(path, Sattr3{Atime: a, Mtime: m})
+}
+
+// ChtimesServer sets the access time, the modification time or both of path
+// to the server's current time, leaving the other unchanged.
+func (v *Target) ChtimesServer(path string, atime, mtime bool) error {
+	return v.Setattr(path, Sattr3{
+		Atime: serverTime(atime),
+		Mtime: serverTime(mtime),
+	})
+}
+
+// Chmod is like Target.Chmod, for the file.
+func (f *File) Chmod(mode os.FileMode) error {
+	return f.setattr(f.fh, hex.EncodeToString(f.fh), chmodAttr(mode), Sattrguard3{})
+}
+
+// Truncate changes the size of the file, cutting it short or extending it
+// with zeros. Data written before is committed first, so that it cannot be
+// sent again past the new end. The offset of the next Read or Write is left
+// as it is.
+func (f *File) Truncate(size int64) error {
+	if size < 0 {
+		return os.ErrInvalid
+	}
+
+	if err := f.Sync(); err != nil {
+		return err
+	}
+
+	return f.setattr(f.fh, hex.EncodeToString(f.fh), truncateAttr(size), Sattrguard3{})
+}
+
+func chmodAttr(mode os.FileMode) Sattr3 {
+	m := uint32(mode.Perm())
+	if mode&os.ModeSetuid != 0 {
+		m |= 04000
+	}
+	if mode&os.ModeSetgid != 0 {
+		m |= 02000
+	}
+	if mode&os.ModeSticky != 0 {
+		m |= 01000
+	}
+
+	return Sattr3{Mode: SetMode{SetIt: true, Mode: m}}
+}
+
+func truncateAttr(size int64) Sattr3 {
+	return Sattr3{Size: SetSize{SetIt: true, Size: uint64(size)}}
+}
+
+func setTime(t time.Time) (SetTime, error) {
+	if t.IsZero() {
+		return SetTime{SetIt: DontChange}, nil
+	}
+
+	if sec := t.Unix(); sec < 0 || sec > math.MaxUint32 {
+		return SetTime{}, fmt.Errorf("time %s cannot be represented in NFSv3", t)
+	}
+
+	return SetTime{
+		SetIt: SetToClientTime,
+		Time:  NFS3Time{Seconds: uint32(t.Unix()), Nseconds: uint32(t.Nanosecond())},
+	}, nil
+}
+
+func serverTime(set bool) SetTime {
+	if !set {
+		return SetTime{SetIt: DontChange}
+	}
+
+	return SetTime{SetIt: SetToServerTime}
+}
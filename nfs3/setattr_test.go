@@ -0,0 +1,36 @@
+package nfs3
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetTime(t *testing.T) {
+	for _, tt := range []struct {
+		t    time.Time
+		want SetTime
+		err  bool
+	}{
+		{t: time.Time{}, want: SetTime{SetIt: DontChange}},
+		{t: time.Unix(0, 0), want: SetTime{SetIt: SetToClientTime}},
+		{
+			t:    time.Unix(1<<32-1, 999999999),
+			want: SetTime{SetIt: SetToClientTime, Time: NFS3Time{Seconds: 1<<32 - 1, Nseconds: 999999999}},
+		},
+		{t: time.Unix(-1, 0), err: true},
+		{t: time.Unix(1<<32, 0), err: true},
+		{t: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), err: true},
+	} {
+		got, err := setTime(tt.t)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("setTime(%s) = %+v, %v", tt.t, got, err)
+		}
+	}
+
+	if got := serverTime(true); got.SetIt != SetToServerTime {
+		t.Errorf("serverTime(true) = %+v", got)
+	}
+	if got := serverTime(false); got.SetIt != DontChange {
+		t.Errorf("serverTime(false) = %+v", got)
+	}
+}
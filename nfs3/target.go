@@ -1,57 +1,118 @@
 package nfs3
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"github.com/aobco/nfs/nfs3/lru"
-	"io"
 	"os"
 	"path"
 	"path/filepath"
-	"strings"
+	"sync"
+	"time"
 
 	"github.com/aobco/log"
+	"github.com/aobco/nfs/internal"
 	"github.com/aobco/nfs/nfs3/rpc"
-	"github.com/aobco/nfs/nfs3/xdr"
 )
 
 type Target struct {
 	*rpc.Client
 
+	// conns holds every connection calls are spread across, Client being
+	// the first of them.
+	conns   []*rpc.Client
+	balance Balance
+	next    uint32
+
 	Auth    rpc.Auth
 	fh      []byte
 	dirPath string
 	fsinfo  *FSInfo
 
 	fhCache *lru.LRUCache
+	attrs   *attrCache
+
+	// fhExpires holds when the cached handles of files other than
+	// directories must be looked up again.
+	fhMu      sync.Mutex
+	fhExpires map[string]time.Time
+
+	// addr and opts are what the Target was dialed with, if it was, for
+	// reaching the server's lock manager.
+	addr   string
+	opts   DialOptions
+	lockMu sync.Mutex
+	locks  *LockManager
 }
 
 func NewTarget(addr string, auth rpc.Auth, fh []byte, dirpath string) (*Target, error) {
-	m := rpc.Mapping{
-		Prog: Nfs3Prog,
-		Vers: Nfs3Vers,
-		Prot: rpc.IPProtoTCP,
-		Port: 0,
+	return newTarget(context.Background(), addr, &DialOptions{}, auth, fh, dirpath)
+}
+
+// NewTargetNconnect is like NewTarget, but opens n connections to the server
+// and spreads calls across them according to balance. Every File opened from
+// the Target shares the same connections.
+func NewTargetNconnect(addr string, n int, balance Balance, auth rpc.Auth, fh []byte, dirpath string) (*Target, error) {
+	return newTarget(context.Background(), addr, &DialOptions{Nconnect: n, Balance: balance}, auth, fh, dirpath)
+}
+
+// NewTargetWithOptions is like NewTarget, with opts controlling how the NFS
+// service is reached. opts may be nil.
+func NewTargetWithOptions(addr string, auth rpc.Auth, fh []byte, dirpath string, opts *DialOptions) (*Target, error) {
+	if opts == nil {
+		opts = &DialOptions{}
+	}
+
+	return newTarget(context.Background(), addr, opts, auth, fh, dirpath)
+}
+
+func newTarget(ctx context.Context, addr string, opts *DialOptions, auth rpc.Auth, fh []byte, dirpath string) (*Target, error) {
+	conns, err := dialConns(ctx, addr, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	client, err := DialService(addr, m)
+	vol, err := newTargetWithClients(ctx, conns, opts.Balance, auth, fh, dirpath)
 	if err != nil {
+		for _, c := range conns {
+			c.Close()
+		}
 		return nil, err
 	}
 
-	return NewTargetWithClient(client, auth, fh, dirpath)
+	vol.addr, vol.opts = addr, *opts
+	return vol, nil
 }
 
 func NewTargetWithClient(client *rpc.Client, auth rpc.Auth, fh []byte, dirpath string) (*Target, error) {
+	return newTargetWithClients(context.Background(), []*rpc.Client{client}, RoundRobin, auth, fh, dirpath)
+}
+
+// NewTargetWithClients is like NewTargetWithClient, but spreads calls across
+// several connections to the same server according to balance.
+func NewTargetWithClients(clients []*rpc.Client, balance Balance, auth rpc.Auth, fh []byte, dirpath string) (*Target, error) {
+	return newTargetWithClients(context.Background(), clients, balance, auth, fh, dirpath)
+}
+
+func newTargetWithClients(ctx context.Context, clients []*rpc.Client, balance Balance, auth rpc.Auth, fh []byte, dirpath string) (*Target, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("no connections for target")
+	}
+
 	vol := &Target{
-		Client:  client,
+		Client:  clients[0],
+		conns:   clients,
+		balance: balance,
 		Auth:    auth,
 		fh:      fh,
 		dirPath: dirpath,
 		fhCache: lru.NewLRUCache(10240),
+		attrs:   newAttrCache(&DefaultAttrCacheOptions),
 	}
-	vol.fhCache.Add(".", fh)
-	vol.fhCache.Add("", fh)
-	vol.fhCache.Add("/", fh)
-	fsinfo, err := vol.FSInfo()
+	fsinfo, err := vol.fsInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -62,323 +123,351 @@ func NewTargetWithClient(client *rpc.Client, auth rpc.Auth, fh []byte, dirpath s
 	return vol, nil
 }
 
-// wraps the Call function to check status and decode errors
-func (v *Target) call(c interface{}) (io.ReadSeeker, error) {
-	res, err := v.Call(c)
+func (v *Target) FSInfo() (*FSInfo, error) {
+	return v.fsInfo(context.Background())
+}
+
+func (v *Target) fsInfo(ctx context.Context) (*FSInfo, error) {
+	proc := &internal.XdrProc_NFSPROC3_FSINFO{Arg: &internal.FSINFO3args{
+		Fsroot: internal.Nfs_fh3{Data: v.fh},
+	}}
+
+	err := v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
+		log.Debugf("fsroot: %s", err.Error())
 		return nil, err
 	}
 
-	status, err := xdr.ReadUint32(res)
+	return fsInfoFrom(proc.Res.Resok()), nil
+}
+
+// StatFS returns the size and free space of the file system the Target is
+// mounted on.
+func (v *Target) StatFS() (*FSStat, error) {
+	return v.StatFSContext(context.Background())
+}
+
+// StatFSContext is like StatFS, but gives up once ctx is done.
+func (v *Target) StatFSContext(ctx context.Context) (*FSStat, error) {
+	proc := &internal.XdrProc_NFSPROC3_FSSTAT{Arg: &internal.FSSTAT3args{
+		Fsroot: internal.Nfs_fh3{Data: v.fh},
+	}}
+
+	err := v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
+		log.Debugf("fsstat: %s", err.Error())
 		return nil, err
 	}
 
-	if err = NFS3Error(status); err != nil {
-		return nil, err
-	}
+	return fsStatFrom(proc.Res.Resok()), nil
+}
 
-	return res, nil
+// PathConf returns the pathconf information for path.
+func (v *Target) PathConf(path string) (*PathConf, error) {
+	return v.PathConfContext(context.Background(), path)
 }
 
-func (v *Target) FSInfo() (*FSInfo, error) {
-	type FSInfoArgs struct {
-		rpc.Header
-		FsRoot []byte
-	}
-
-	res, err := v.call(&FSInfoArgs{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3FSInfo,
-			Cred:    v.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		FsRoot: v.fh,
-	})
+// PathConfContext is like PathConf, but gives up once ctx is done.
+func (v *Target) PathConfContext(ctx context.Context, path string) (*PathConf, error) {
+	var pc *PathConf
+	err := v.retryStale(func() (err error) {
+		pc, err = v.pathConf(ctx, path)
+		return err
+	}, path)
+
+	return pc, err
+}
 
+func (v *Target) pathConf(ctx context.Context, path string) (*PathConf, error) {
+	_, fh, err := v.lookup2(ctx, path)
 	if err != nil {
-		log.Debugf("fsroot: %s", err.Error())
 		return nil, err
 	}
 
-	fsinfo := new(FSInfo)
-	if err = xdr.Read(res, fsinfo); err != nil {
+	proc := &internal.XdrProc_NFSPROC3_PATHCONF{Arg: &internal.PATHCONF3args{
+		Object: internal.Nfs_fh3{Data: fh},
+	}}
+
+	err = v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
+	if err != nil {
+		log.Debugf("pathconf(%s): %s", path, err.Error())
 		return nil, err
 	}
 
-	return fsinfo, nil
+	return pathConfFrom(proc.Res.Resok()), nil
 }
 
 // Lookup returns attributes and the file handle to a given dirent
 func (v *Target) Lookup(p string) (os.FileInfo, []byte, error) {
-	return v.lookup2(p)
+	return v.LookupContext(context.Background(), p)
 }
 
-// Lookup returns attributes and the file handle to a given dirent
-func (v *Target) lookup2(p string) (*Fattr, []byte, error) {
-	if pfh, ok := v.fhCache.Get(p); ok {
-		return nil, pfh, nil
+// LookupContext is like Lookup, but gives up once ctx is done.
+func (v *Target) LookupContext(ctx context.Context, p string) (os.FileInfo, []byte, error) {
+	var (
+		attr *Fattr
+		fh   []byte
+	)
+	err := v.retryStale(func() (err error) {
+		attr, fh, err = v.lookup2(ctx, p)
+		if err == nil && attr == nil {
+			attr, err = v.getattrNoCache(ctx, fh, p)
+		}
+		return err
+	}, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attr, fh, nil
+}
+
+// lookup2 is Lookup, starting from the nearest cached ancestor. The
+// attributes are nil when p itself is cached and its attributes are not. A
+// cached handle the server calls stale is evicted, and the walk starts again
+// further up.
+func (v *Target) lookup2(ctx context.Context, p string) (*Fattr, []byte, error) {
+	p = cachePath(p)
+	if pfh, ok := v.cachedFH(p); ok {
+		attr, _ := v.attrs.get(pfh)
+		return attr, pfh, nil
+	}
+
+	for {
+		fattr, fh, dir, err := v.lookupFrom(ctx, p)
+		if !IsStaleError(err) || dir == "/" {
+			return fattr, fh, err
+		}
+
+		log.Debugf("lookup %s: %s is stale, looking it up again", p, dir)
+		v.evict(dir)
 	}
-	p = strings.TrimSuffix(p, "/")
+}
+
+// lookupFrom looks p up from its nearest cached ancestor, which it returns,
+// caching the handles on the way.
+func (v *Target) lookupFrom(ctx context.Context, p string) (*Fattr, []byte, string, error) {
+	dir := path.Dir(p)
+	names := []string{path.Base(p)}
+	fh, ok := v.cachedFH(dir)
+	for !ok {
+		names = append(names, path.Base(dir))
+		dir = path.Dir(dir)
+		fh, ok = v.cachedFH(dir)
+	}
+
 	var (
-		err   error
 		fattr *Fattr
-		fh    []byte = v.fh
+		err   error
 	)
-	pDir := path.Dir(p)
-	file := path.Base(p)
-	// log.Debugf("LOOKUP path %s pDir %s", p, pDir)
-	if pfh, ok := v.fhCache.Get(pDir); ok {
-		fattr, fh, err = v.lookup(pfh, file)
+	walked := dir
+	for i := len(names) - 1; i >= 0; i-- {
+		fattr, fh, err = v.lookup(ctx, fh, names[i])
 		if err != nil {
-			return nil, nil, err
-		}
-		if fattr != nil && fattr.IsDir() {
-			v.fhCache.Add(p, fh)
+			return nil, nil, dir, err
 		}
-		return fattr, fh, err
-	}
-	pfh := v.fh
-	dirents := strings.Split(path.Clean(pDir), "/")
-	for i, dirent := range dirents {
-		join := strings.Join(dirents[:i+1], "/")
-		if cfh, ok := v.fhCache.Get(join); ok {
-			pfh = cfh
-			log.Debugf("LOOKUP path %s join %s cache fh %v", p, join, pfh)
+
+		// Everything but the last name was a directory to look in.
+		walked = path.Join(walked, names[i])
+		if i > 0 {
+			v.cacheDirFH(walked, fh)
 		} else {
-			_, pfh, err = v.lookup(pfh, dirent)
-			if err != nil {
-				log.Warnf("lookup %s %v", p, err)
-				return nil, nil, err
-			}
+			v.cacheFH(walked, fh, fattr)
 		}
-		// log.Debugf("LOOKUP path %s join %s fh %v", p, join, pfh)
 	}
-	fattr, fh, err = v.lookup(pfh, file)
-	if err != nil {
-		// log.Warnf("lookup %s %v", p, err)
-		return nil, nil, err
-	}
-	if fattr != nil && fattr.IsDir() {
-		v.fhCache.Add(p, fh)
-	}
-	// log.Debugf("lookup path %s fh %v", p, fh)
-	return fattr, fh, nil
+
+	return fattr, fh, dir, nil
 }
 
 // lookup returns the same as above, but by fh and name
-func (v *Target) lookup(fh []byte, name string) (*Fattr, []byte, error) {
+func (v *Target) lookup(ctx context.Context, fh []byte, name string) (*Fattr, []byte, error) {
 	log.Warnf("lookup %s", name)
-	type Lookup3Args struct {
-		rpc.Header
-		What Diropargs3
-	}
+	proc := &internal.XdrProc_NFSPROC3_LOOKUP{Arg: &internal.LOOKUP3args{
+		What: diropargs(fh, name),
+	}}
 
-	type LookupOk struct {
-		FH      []byte
-		Attr    PostOpAttr
-		DirAttr PostOpAttr
+	err := v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
 	}
-
-	res, err := v.call(&Lookup3Args{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Lookup,
-			Cred:    v.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		What: Diropargs3{
-			FH:       fh,
-			Filename: name,
-		},
-	})
-
 	if err != nil {
 		log.Warnf("lookup %s %s", name, err.Error())
 		return nil, nil, err
 	}
 
-	lookupres := new(LookupOk)
-	if err := xdr.Read(res, lookupres); err != nil {
-		log.Errorf("lookup(%s) failed to parse return: %s", name, err)
-		return nil, nil, err
-	}
-
-	// log.Debugf("lookup(%s): FH 0x%x, attr: %+v", name, lookupres.FH, lookupres.Attr.Attr)
-	return &lookupres.Attr.Attr, lookupres.FH, nil
+	res := proc.Res.Resok()
+	v.attrs.postOp(res.Object.Data, &res.Obj_attributes)
+	v.attrs.postOp(fh, &res.Dir_attributes)
+	attr := postOpAttrFrom(&res.Obj_attributes)
+	// log.Debugf("lookup(%s): FH 0x%x, attr: %+v", name, res.Object.Data, attr.Attr)
+	return &attr.Attr, res.Object.Data, nil
 }
 
 // Access file
 func (v *Target) Access(path string, mode uint32) (uint32, error) {
+	var access uint32
+	err := v.retryStale(func() error {
+		_, fh, err := v.lookup2(context.Background(), path)
+		if err != nil {
+			return err
+		}
 
-	_, fh, err := v.Lookup(path)
-	if err != nil {
-		return 0, err
-	}
-
-	_, mode, err = v.access(fh, path, mode)
+		_, access, err = v.access(fh, path, mode)
+		return err
+	}, path)
 
-	return mode, err
+	return access, err
 }
 
 // access returns the same as above, but by fh and name
 func (v *Target) access(fh []byte, path string, access uint32) (*Fattr, uint32, error) {
-	type Access3Args struct {
-		rpc.Header
-		FH     []byte
-		Access uint32
-	}
+	proc := &internal.XdrProc_NFSPROC3_ACCESS{Arg: &internal.ACCESS3args{
+		Object: internal.Nfs_fh3{Data: fh},
+		Access: access,
+	}}
 
-	type AccessOk struct {
-		Attr   PostOpAttr
-		Access uint32
+	err := v.callProc(context.Background(), proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
 	}
-
-	res, err := v.call(&Access3Args{Header: rpc.Header{
-		Rpcvers: 2,
-		Prog:    Nfs3Prog,
-		Vers:    Nfs3Vers,
-		Proc:    NFSProc3Access,
-		Cred:    v.Auth,
-		Verf:    rpc.AuthNull,
-	},
-		FH:     fh,
-		Access: access})
-
 	if err != nil {
 		log.Debugf("access(%s): %s", path, err.Error())
 		return nil, 0, err
 	}
 
-	accessres := new(AccessOk)
-
-	if err := xdr.Read(res, accessres); err != nil {
-		log.Errorf("access(%s) failed to parse return: %s", path, err)
-		log.Debugf("access partial decode: %+v", *accessres)
-		return nil, 0, err
-	}
-
-	log.Debugf("access(%s): access %d, attr: %+v", path, accessres.Access, accessres.Attr)
+	res := proc.Res.Resok()
+	v.attrs.postOp(fh, &res.Obj_attributes)
+	attr := postOpAttrFrom(&res.Obj_attributes)
+	log.Debugf("access(%s): access %d, attr: %+v", path, res.Access, attr)
 
-	return &accessres.Attr.Attr, accessres.Access, nil
+	return &attr.Attr, res.Access, nil
 }
 
-// Getattr file
+// Getattr returns the attributes of path, from the attribute cache while
+// they are fresh there.
 func (v *Target) Getattr(path string) (*Fattr, error) {
+	var attr *Fattr
+	err := v.retryStale(func() error {
+		_, fh, err := v.lookup2(context.Background(), path)
+		if err != nil {
+			return err
+		}
 
-	_, fh, err := v.Lookup(path)
-	if err != nil {
-		return nil, err
-	}
-
-	attr, err := v.getattr(fh, path)
+		attr, err = v.getattr(fh, path)
+		return err
+	}, path)
 
 	return attr, err
 }
 
 func (v *Target) getattr(fh []byte, path string) (*Fattr, error) {
-
-	type Getattr3Args struct {
-		rpc.Header
-		FH []byte
+	if attr, ok := v.attrs.get(fh); ok {
+		return attr, nil
 	}
 
-	type GetattrOk struct {
-		Attr Fattr
-	}
+	return v.getattrNoCache(context.Background(), fh, path)
+}
 
-	res, err := v.call(&Getattr3Args{Header: rpc.Header{
-		Rpcvers: 2,
-		Prog:    Nfs3Prog,
-		Vers:    Nfs3Vers,
-		Proc:    NFSProc3Getattr,
-		Cred:    v.Auth,
-		Verf:    rpc.AuthNull,
-	},
-		FH: fh})
+// getattrNoCache asks the server for the attributes of fh, whatever the
+// cache holds, and caches them.
+func (v *Target) getattrNoCache(ctx context.Context, fh []byte, path string) (*Fattr, error) {
+	proc := &internal.XdrProc_NFSPROC3_GETATTR{Arg: &internal.GETATTR3args{
+		Object: internal.Nfs_fh3{Data: fh},
+	}}
 
+	err := v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
 		log.Debugf("getattr(%s): %s", path, err.Error())
 		return nil, err
 	}
 
-	getattrres := new(GetattrOk)
+	attr := fattrFrom(&proc.Res.Resok().Obj_attributes)
+	log.Debugf("getattr(%s): attr: %+v", path, attr)
+	v.attrs.put(fh, &attr)
 
-	if err := xdr.Read(res, getattrres); err != nil {
-		log.Errorf("getattr(%s) failed to parse return: %s", path, err)
-		log.Debugf("getattr partial decode: %+v", *getattrres)
-		return nil, err
-	}
+	return &attr, nil
+}
 
-	log.Debugf("getattr(%s): attr: %+v", path, getattrres.Attr)
+// Setattr sets the attributes of path that sattr asks for. Chmod, Chown,
+// Truncate and Chtimes are simpler to use for a single change.
+func (v *Target) Setattr(path string, sattr Sattr3) error {
+	return v.setattrPath(path, sattr, Sattrguard3{})
+}
 
-	return &getattrres.Attr, nil
+// SetattrGuarded is like Setattr, but only changes the attributes if the
+// ctime of path is still ctime, as read from its attributes. Otherwise it
+// fails with a *NotSyncError, and the attributes are left as they are.
+func (v *Target) SetattrGuarded(path string, sattr Sattr3, ctime NFS3Time) error {
+	return v.setattrPath(path, sattr, Sattrguard3{Check: 1, Time: ctime})
 }
 
-// Setattr set file attr
-func (v *Target) Setattr(path string, sattr Sattr3) error {
-	attr, fh, err := v.lookup2(path)
-	if err != nil {
-		return err
-	}
+func (v *Target) setattrPath(path string, sattr Sattr3, guard Sattrguard3) error {
+	return v.retryStale(func() error {
+		_, fh, err := v.lookup2(context.Background(), path)
+		if err != nil {
+			return err
+		}
 
-	err = v.setattr(fh, path, sattr, Sattrguard3{Check: 1, Time: attr.Ctime})
-	return err
+		return v.setattr(fh, path, sattr, guard)
+	}, path)
 }
 
 func (v *Target) setattr(fh []byte, path string, sattr Sattr3, guard Sattrguard3) error {
+	proc := &internal.XdrProc_NFSPROC3_SETATTR{Arg: &internal.SETATTR3args{
+		Object:         internal.Nfs_fh3{Data: fh},
+		New_attributes: sattrTo(&sattr),
+		Guard:          guardTo(guard),
+	}}
 
-	type Setattr3Args struct {
-		rpc.Header
-		FH    []byte
-		Sattr Sattr3
-		Guard Sattrguard3
+	err := v.callProc(context.Background(), proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
 	}
-
-	type SetattrOk struct {
-		FileWcc WccData
-	}
-
-	res, err := v.call(&Setattr3Args{Header: rpc.Header{
-		Rpcvers: 2,
-		Prog:    Nfs3Prog,
-		Vers:    Nfs3Vers,
-		Proc:    NFSProc3Setattr,
-		Cred:    v.Auth,
-		Verf:    rpc.AuthNull,
-	},
-		FH:    fh,
-		Sattr: sattr,
-		Guard: guard})
 	if err != nil {
 		log.Debugf("setattr(%s): %s", path, err.Error())
+		if nfsErr, ok := err.(*Error); ok && nfsErr.ErrorNum == NFS3ErrNotSync {
+			v.attrs.invalidate(fh)
+			return &NotSyncError{Path: path, Ctime: guard.Time}
+		}
 		return err
 	}
 
-	setattrres := new(SetattrOk)
-
-	if err := xdr.Read(res, setattrres); err != nil {
-		log.Errorf("setattr(%s) failed to parse return: %s", path, err)
-		log.Debugf("setattr partial decode: %+v", *setattrres)
-		return err
-	}
-	log.Debugf("setattr(%s): FileWcc: %+v", path, setattrres.FileWcc)
+	log.Debugf("setattr(%s): FileWcc: %+v", path, proc.Res.Resok().Obj_wcc)
+	v.attrs.wcc(fh, &proc.Res.Resok().Obj_wcc)
 	return nil
 }
 
 // ReadDirPlus get dir sub item
 func (v *Target) ReadDirPlus(dir string) ([]*EntryPlus, error) {
-	_, fh, err := v.Lookup(dir)
-	if err != nil {
-		return nil, err
-	}
+	return v.ReadDirPlusContext(context.Background(), dir)
+}
+
+// ReadDirPlusContext is like ReadDirPlus, but gives up once ctx is done.
+func (v *Target) ReadDirPlusContext(ctx context.Context, dir string) ([]*EntryPlus, error) {
+	var entries []*EntryPlus
+	err := v.retryStale(func() error {
+		_, fh, err := v.lookup2(ctx, dir)
+		if err != nil {
+			return err
+		}
+
+		entries, err = v.readDirPlus(ctx, fh)
+		return err
+	}, dir)
 
-	return v.readDirPlus(fh)
+	return entries, err
 }
 
 type ReadDirPlus3Args struct {
@@ -390,340 +479,373 @@ type ReadDirPlus3Args struct {
 	MaxCount   uint32
 }
 
+// Deprecated: DirListOK is no longer used; READDIRPLUS results are decoded
+// with the types generated from nfs3.x.
 type DirListOK struct {
 	DirAttrs   PostOpAttr
 	CookieVerf uint64
 }
 
+// Deprecated: DirListPlus3 is no longer used; READDIRPLUS results are decoded
+// with the types generated from nfs3.x.
 type DirListPlus3 struct {
 	IsSet bool      `xdr:"union"`
 	Entry EntryPlus `xdr:"unioncase=1"`
 }
 
-func (v *Target) readDirPlus(fh []byte) ([]*EntryPlus, error) {
-	cookie := uint64(0)
-	cookieVerf := uint64(0)
-	eof := false
+func (v *Target) readDirPlus(ctx context.Context, fh []byte) ([]*EntryPlus, error) {
+	d := v.openDir(ctx, fh, nil)
 
 	var entries []*EntryPlus
-	for !eof {
-		res, err := v.call(&ReadDirPlus3Args{
-			Header: rpc.Header{
-				Rpcvers: 2,
-				Prog:    Nfs3Prog,
-				Vers:    Nfs3Vers,
-				Proc:    NFSProc3ReadDirPlus,
-				Cred:    v.Auth,
-				Verf:    rpc.AuthNull,
-			},
-			FH:         fh,
-			Cookie:     cookie,
-			CookieVerf: cookieVerf,
-			DirCount:   10240, // 应返回的目录信息字节数
-			MaxCount:   20480, // (优先级更高) 会覆盖 dircount 参数的设置。
-		})
-
-		if err != nil {
-			log.Debugf("readdir(%x): %s", fh, err.Error())
-			return nil, err
-		}
-
-		// The dir list entries are so-called "optional-data".  We need to check
-		// the Follows fields before continuing down the array.  Effectively, it's
-		// an encoding used to flatten a linked list into an array where the
-		// Follows field is set when the next idx has data. See
-		// https://tools.ietf.org/html/rfc4506.html#section-4.19 for details.
-		dirlistOK := new(DirListOK)
-		if err = xdr.Read(res, dirlistOK); err != nil {
-			log.Errorf("readdir failed to parse result (%x): %s", fh, err.Error())
-			log.Debugf("partial dirlist: %+v", dirlistOK)
-			return nil, err
-		}
-
-		for {
-			var item DirListPlus3
-			if err = xdr.Read(res, &item); err != nil {
-				log.Errorf("readdir failed to parse directory entry, aborting")
-				log.Debugf("partial dirent: %+v", item)
-				return nil, err
-			}
-
-			if !item.IsSet {
-				break
-			}
-
-			cookie = item.Entry.Cookie
-			entries = append(entries, &item.Entry)
-		}
+	for d.Next() {
+		entries = append(entries, d.Entry())
+	}
 
-		if err = xdr.Read(res, &eof); err != nil {
-			log.Errorf("readdir failed to determine presence of more data to read, aborting")
-			return nil, err
-		}
-		log.Debugf("No EOF for dirents so calling back for more")
-		cookieVerf = dirlistOK.CookieVerf
+	if err := d.Err(); err != nil {
+		log.Debugf("readdir(%x): %s", fh, err.Error())
+		return nil, err
 	}
 
 	return entries, nil
 }
 
 func (v *Target) ReadDirN(request *ReadDirPlus3Args) ([]*EntryPlus, error) {
-	res, err := v.call(request)
+	entries, _, err := v.readDirPlusPage(context.Background(), request)
 	if err != nil {
 		log.Errorf("step readdir fail %v", err)
 		return nil, err
 	}
-	eof := false
 
-	dirlistOK := new(DirListOK)
-	if err = xdr.Read(res, dirlistOK); err != nil {
-		log.Errorf("readdir failed to parse result: %v", err)
-		log.Debugf("partial dir list: %+v", dirlistOK)
-		return nil, err
-	}
-	var entries []*EntryPlus
+	return entries, nil
+}
 
-	for {
-		var item DirListPlus3
-		if err = xdr.Read(res, &item); err != nil {
-			log.Errorf("readdir failed to parse directory entry, aborting")
-			log.Debugf("partial dirent: %+v", item)
-			return nil, err
-		}
+// readDirPlusPage reads the entries following request.Cookie, and moves the
+// cookie and its verifier past them.
+func (v *Target) readDirPlusPage(ctx context.Context, request *ReadDirPlus3Args) ([]*EntryPlus, bool, error) {
+	proc := &internal.XdrProc_NFSPROC3_READDIRPLUS{Arg: &internal.READDIRPLUS3args{
+		Dir:      internal.Nfs_fh3{Data: request.FH},
+		Cookie:   request.Cookie,
+		Dircount: request.DirCount,
+		Maxcount: request.MaxCount,
+	}}
+	binary.BigEndian.PutUint64(proc.Arg.Cookieverf[:], request.CookieVerf)
+
+	err := callProc(ctx, v.conn(), request.Cred, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
+	if err != nil {
+		return nil, false, err
+	}
 
-		if !item.IsSet {
-			break
-		}
+	res := proc.Res.Resok()
+	v.attrs.postOp(request.FH, &res.Dir_attributes)
 
-		request.Cookie = item.Entry.Cookie
-		entries = append(entries, &item.Entry)
+	var entries []*EntryPlus
+	for e := res.Reply.Entries; e != nil; e = e.Nextentry {
+		request.Cookie = e.Cookie
+		v.cacheEntry(e)
+		entries = append(entries, entryPlusFrom(e))
 	}
+	request.CookieVerf = binary.BigEndian.Uint64(res.Cookieverf[:])
 
-	if err = xdr.Read(res, &eof); err != nil {
-		log.Errorf("readdir failed to determine presence of more data to read, aborting")
-		return nil, err
-	}
-	log.Debugf("No EOF for dirents so calling back for more")
-	request.CookieVerf = dirlistOK.CookieVerf
-	return entries, nil
+	return entries, res.Reply.Eof, nil
 }
 
 // Mkdir Creates a directory of the given name and returns its handle
 func (v *Target) Mkdir(path string, perm os.FileMode) ([]byte, error) {
-	if p, ok := v.fhCache.Get(path); ok {
-		return p, nil
+	return v.MkdirContext(context.Background(), path, perm)
+}
+
+// MkdirContext is like Mkdir, but gives up once ctx is done.
+func (v *Target) MkdirContext(ctx context.Context, path string, perm os.FileMode) ([]byte, error) {
+	if fh, ok := v.cachedFH(cachePath(path)); ok {
+		// Only trust the cache if the directory is still there, which the
+		// attribute cache cannot tell.
+		attr, err := v.getattrNoCache(ctx, fh, path)
+		if err == nil && attr.IsDir() {
+			return fh, nil
+		}
+		v.evict(path)
 	}
+
+	var fh []byte
+	err := v.retryStale(func() (err error) {
+		fh, err = v.mkdir(ctx, path, perm)
+		return err
+	}, filepath.Dir(path))
+
+	return fh, err
+}
+
+func (v *Target) mkdir(ctx context.Context, path string, perm os.FileMode) ([]byte, error) {
 	dir := filepath.Dir(path)
 	newDir := filepath.Base(path)
-	_, fh, err := v.Lookup(dir)
+	_, fh, err := v.lookup2(ctx, dir)
 	if err != nil {
 		log.Warnf("lookup %s fail %v", dir, err)
 		return nil, err
 	}
 
-	type MkdirArgs struct {
-		rpc.Header
-		Where Diropargs3
-		Attrs Sattr3
-	}
-
-	type MkdirOk struct {
-		FH     PostOpFH3
-		Attr   PostOpAttr
-		DirWcc WccData
-	}
-
-	args := &MkdirArgs{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Mkdir,
-			Cred:    v.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		Where: Diropargs3{
-			FH:       fh,
-			Filename: newDir,
-		},
-		Attrs: Sattr3{
+	proc := &internal.XdrProc_NFSPROC3_MKDIR{Arg: &internal.MKDIR3args{
+		Where: diropargs(fh, newDir),
+		Attributes: sattrTo(&Sattr3{
 			Mode: SetMode{
 				SetIt: true,
 				Mode:  uint32(perm.Perm()),
 			},
-		},
-	}
-	res, err := v.call(args)
+		}),
+	}}
 
+	err = v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
 		log.Warnf("mkdir %s fail %v", path, err)
 		return nil, err
 	}
 
-	mkdirres := new(MkdirOk)
-	if err := xdr.Read(res, mkdirres); err != nil {
-		log.Errorf("mkdir(%s) failed to parse return: %s", path, err)
-		log.Debugf("mkdir(%s) partial response: %+v", mkdirres)
-		return nil, err
+	res := proc.Res.Resok()
+	v.dirWcc(fh, &res.Dir_wcc)
+	obj := postOpFHFrom(&res.Obj)
+	if !obj.IsSet {
+		return nil, errors.New("fh not set")
 	}
+	v.attrs.postOp(obj.FH, &res.Obj_attributes)
 
-	log.Debugf("mkdir(%s): created successfully (0x%x)", path, fh)
-	v.fhCache.Add(path, mkdirres.FH.FH)
-	return mkdirres.FH.FH, nil
+	log.Debugf("mkdir(%s): created successfully (0x%x)", path, obj.FH)
+	v.cacheDirFH(cachePath(path), obj.FH)
+	return obj.FH, nil
 }
 
 // Create a file with name the given mode
 func (v *Target) Create(path string, perm os.FileMode) ([]byte, error) {
+	return v.CreateContext(context.Background(), path, perm)
+}
+
+// CreateContext is like Create, but gives up once ctx is done.
+func (v *Target) CreateContext(ctx context.Context, path string, perm os.FileMode) ([]byte, error) {
+	dir, newFile := filepath.Split(path)
+
+	var newfh []byte
+	err := v.retryStale(func() error {
+		_, fh, err := v.lookup2(ctx, dir)
+		log.Infof("create %s %x -> %s", dir, fh, newFile)
+		if err != nil && err != os.ErrNotExist {
+			log.Warnf("%v", err)
+			return err
+		}
+
+		newfh, err = v.create(ctx, path, fh, newFile, createHow(internal.UNCHECKED, perm))
+		return err
+	}, dir)
+
+	return newfh, err
+}
+
+// CreateExclusive creates a file with the given mode, failing with an error
+// satisfying os.IsExist when it already exists. It uses an EXCLUSIVE create,
+// which the server recognizes when a call is sent again because its reply was
+// lost, falling back to GUARDED for servers that do not support it.
+func (v *Target) CreateExclusive(path string, perm os.FileMode) ([]byte, error) {
+	return v.CreateExclusiveContext(context.Background(), path, perm)
+}
+
+// CreateExclusiveContext is like CreateExclusive, but gives up once ctx is
+// done.
+func (v *Target) CreateExclusiveContext(ctx context.Context, path string, perm os.FileMode) ([]byte, error) {
+	var fh []byte
+	err := v.retryStale(func() (err error) {
+		fh, err = v.createExclusive(ctx, path, perm)
+		return err
+	}, filepath.Dir(path))
+
+	return fh, err
+}
+
+func (v *Target) createExclusive(ctx context.Context, path string, perm os.FileMode) ([]byte, error) {
 	dir, newFile := filepath.Split(path)
-	_, fh, err := v.Lookup(dir)
-	log.Infof("create %s %x -> %s", dir, fh, newFile)
-	if err != nil && err != os.ErrNotExist {
-		log.Warnf("%v", err)
+	_, dirfh, err := v.lookup2(ctx, dir)
+	if err != nil {
 		return nil, err
 	}
 
-	type How struct {
-		// 0 : UNCHECKED (default)
-		// 1 : GUARDED
-		// 2 : EXCLUSIVE
-		Mode uint32
-		Attr Sattr3
+	var how internal.Createhow3
+	how.Mode = internal.EXCLUSIVE
+	if _, err := rand.Read(how.Verf()[:]); err != nil {
+		return nil, err
+	}
+
+	fh, err := v.create(ctx, path, dirfh, newFile, how)
+	for i := 0; i < exclusiveCreateRetries && ctx.Err() == nil && isTransportError(err); i++ {
+		// The server may have created the file before the reply was lost.
+		// With the same verifier it answers as it did the first time rather
+		// than with NFS3ERR_EXIST.
+		log.Debugf("create(%s): retrying: %s", path, err)
+		fh, err = v.create(ctx, path, dirfh, newFile, how)
+	}
+
+	if isNotSupported(err) {
+		log.Debugf("create(%s): exclusive create not supported, using guarded", path)
+		return v.create(ctx, path, dirfh, newFile, createHow(internal.GUARDED, perm))
 	}
-	type Create3Args struct {
-		rpc.Header
-		Where Diropargs3
-		HW    How
+	if err != nil {
+		return nil, err
 	}
 
-	type Create3Res struct {
-		FH     PostOpFH3
-		Attr   PostOpAttr
-		DirWcc WccData
+	// The verifier is kept in the file's times, and an exclusive create sets
+	// no other attributes, so set them now as RFC 1813 asks.
+	err = v.setattr(fh, path, Sattr3{
+		Mode:  SetMode{SetIt: true, Mode: uint32(perm.Perm())},
+		Atime: SetTime{SetIt: SetToServerTime},
+		Mtime: SetTime{SetIt: SetToServerTime},
+	}, Sattrguard3{})
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := v.call(&Create3Args{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Create,
-			Cred:    v.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		Where: Diropargs3{
-			FH:       fh,
-			Filename: newFile,
-		},
-		HW: How{
-			Attr: Sattr3{
-				Mode: SetMode{
-					SetIt: true,
-					Mode:  uint32(perm.Perm()),
-				},
-			},
+	return fh, nil
+}
+
+// exclusiveCreateRetries is how often an exclusive create is sent again after
+// its reply was lost.
+const exclusiveCreateRetries = 2
+
+// createHow returns an UNCHECKED or GUARDED create setting the mode to perm.
+func createHow(mode internal.Createmode3, perm os.FileMode) internal.Createhow3 {
+	var how internal.Createhow3
+	how.Mode = mode
+	*how.Obj_attributes() = sattrTo(&Sattr3{
+		Mode: SetMode{
+			SetIt: true,
+			Mode:  uint32(perm.Perm()),
 		},
 	})
 
+	return how
+}
+
+// create makes name in the directory fh, path being used for logging.
+func (v *Target) create(ctx context.Context, path string, fh []byte, name string, how internal.Createhow3) ([]byte, error) {
+	proc := &internal.XdrProc_NFSPROC3_CREATE{Arg: &internal.CREATE3args{
+		Where: diropargs(fh, name),
+		How:   how,
+	}}
+
+	err := v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
 		log.Debugf("create(%s): %s", path, err.Error())
 		return nil, err
 	}
 
-	status := new(Create3Res)
-	if err = xdr.Read(res, status); err != nil {
-		return nil, err
+	res := proc.Res.Resok()
+	v.dirWcc(fh, &res.Dir_wcc)
+	obj := postOpFHFrom(&res.Obj)
+	if !obj.IsSet {
+		// The server may leave it to us to look the new file up.
+		_, newfh, err := v.lookup(ctx, fh, name)
+		return newfh, err
 	}
+	v.attrs.postOp(obj.FH, &res.Obj_attributes)
 
 	log.Debugf("create(%s): created successfully", path)
-	return status.FH.FH, nil
+	var attr *Fattr
+	if a := postOpAttrFrom(&res.Obj_attributes); a.IsSet {
+		attr = &a.Attr
+	}
+	v.cacheFH(cachePath(path), obj.FH, attr)
+	return obj.FH, nil
 }
 
 // Remove a file
 func (v *Target) Remove(path string) error {
+	return v.RemoveContext(context.Background(), path)
+}
+
+// RemoveContext is like Remove, but gives up once ctx is done.
+func (v *Target) RemoveContext(ctx context.Context, path string) error {
 	parentDir, deleteFile := filepath.Split(path)
-	_, fh, err := v.Lookup(parentDir)
-	if err != nil {
-		return err
-	}
+	defer v.evict(path)
+
+	return v.retryStale(func() error {
+		_, fh, err := v.lookup2(ctx, parentDir)
+		if err != nil {
+			return err
+		}
 
-	return v.remove(fh, deleteFile)
+		return v.remove(ctx, fh, deleteFile)
+	}, parentDir)
 }
 
 // remove the named file from the parent (fh)
-func (v *Target) remove(fh []byte, deleteFile string) error {
-	type RemoveArgs struct {
-		rpc.Header
-		Object Diropargs3
-	}
-
-	_, err := v.call(&RemoveArgs{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Remove,
-			Cred:    v.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		Object: Diropargs3{
-			FH:       fh,
-			Filename: deleteFile,
-		},
-	})
+func (v *Target) remove(ctx context.Context, fh []byte, deleteFile string) error {
+	proc := &internal.XdrProc_NFSPROC3_REMOVE{Arg: &internal.REMOVE3args{
+		Object: diropargs(fh, deleteFile),
+	}}
 
+	err := v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
 		log.Debugf("remove(%s): %s", deleteFile, err.Error())
 		return err
 	}
 
+	v.dirWcc(fh, &proc.Res.Resok().Dir_wcc)
 	return nil
 }
 
 // RmDir removes a non-empty directory
 func (v *Target) RmDir(path string) error {
 	dir, deletedir := filepath.Split(path)
-	_, fh, err := v.Lookup(dir)
-	if err != nil {
-		return err
-	}
+	defer v.evict(path)
+
+	return v.retryStale(func() error {
+		_, fh, err := v.lookup2(context.Background(), dir)
+		if err != nil {
+			return err
+		}
 
-	return v.rmDir(fh, deletedir)
+		return v.rmDir(fh, deletedir)
+	}, dir)
 }
 
 // delete the named directory from the parent directory (fh)
 func (v *Target) rmDir(fh []byte, name string) error {
-	type RmDir3Args struct {
-		rpc.Header
-		Object Diropargs3
-	}
-
-	_, err := v.call(&RmDir3Args{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3RmDir,
-			Cred:    v.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		Object: Diropargs3{
-			FH:       fh,
-			Filename: name,
-		},
-	})
+	proc := &internal.XdrProc_NFSPROC3_RMDIR{Arg: &internal.RMDIR3args{
+		Object: diropargs(fh, name),
+	}}
 
+	err := v.callProc(context.Background(), proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
 		log.Debugf("rmdir(%s): %s", name, err.Error())
 		return err
 	}
 
+	v.dirWcc(fh, &proc.Res.Resok().Dir_wcc)
 	log.Debugf("rmdir(%s): deleted successfully", name)
 	return nil
 }
 
 func (v *Target) RemoveAll(path string) error {
+	defer v.evict(path)
+
+	return v.retryStale(func() error {
+		return v.removeAllPath(path)
+	}, filepath.Dir(path))
+}
+
+func (v *Target) removeAllPath(path string) error {
 	parentDir, deleteDir := filepath.Split(path)
-	_, parentDirfh, err := v.Lookup(parentDir)
+	_, parentDirfh, err := v.lookup2(context.Background(), parentDir)
 	if err != nil {
 		return err
 	}
@@ -740,7 +862,7 @@ func (v *Target) RemoveAll(path string) error {
 		return err
 	}
 
-	_, deleteDirfh, err := v.lookup(parentDirfh, deleteDir)
+	_, deleteDirfh, err := v.lookup(context.Background(), parentDirfh, deleteDir)
 	if err != nil {
 		return err
 	}
@@ -764,7 +886,7 @@ func (v *Target) removeAll(deleteDirfh []byte) error {
 	// all files.
 
 	// This is a directory, get all of its Entries
-	entries, err := v.readDirPlus(deleteDirfh)
+	entries, err := v.readDirPlus(context.Background(), deleteDirfh)
 	if err != nil {
 		return err
 	}
@@ -775,6 +897,16 @@ func (v *Target) removeAll(deleteDirfh []byte) error {
 			continue
 		}
 
+		// Without READDIRPLUS the entry carries no attributes or handle.
+		if !entry.Attr.IsSet {
+			attr, fh, err := v.lookup(context.Background(), deleteDirfh, entry.FileName)
+			if err != nil {
+				return err
+			}
+			entry.Attr = PostOpAttr{IsSet: true, Attr: *attr}
+			entry.Handle = PostOpFH3{IsSet: true, FH: fh}
+		}
+
 		// If directory, recurse, then nuke it.  It should be empty when we get
 		// back.
 		if entry.Attr.Attr.Type == NF3Dir {
@@ -788,7 +920,7 @@ func (v *Target) removeAll(deleteDirfh []byte) error {
 		} else {
 
 			// nuke all files
-			err = v.remove(deleteDirfh, entry.FileName)
+			err = v.remove(context.Background(), deleteDirfh, entry.FileName)
 		}
 
 		if err != nil {
@@ -801,35 +933,164 @@ func (v *Target) removeAll(deleteDirfh []byte) error {
 }
 
 func (v *Target) Rename(fhFrom []byte, fromName string, fhTo []byte, toName string) error {
-	type RenameArgs struct {
-		rpc.Header
-		From Diropargs3
-		To   Diropargs3
-	}
-
-	_, err := v.call(&RenameArgs{
-		Header: rpc.Header{
-			Rpcvers: 2,
-			Prog:    Nfs3Prog,
-			Vers:    Nfs3Vers,
-			Proc:    NFSProc3Rename,
-			Cred:    v.Auth,
-			Verf:    rpc.AuthNull,
-		},
-		From: Diropargs3{
-			FH:       fhFrom,
-			Filename: fromName,
-		},
-		To: Diropargs3{
-			FH:       fhTo,
-			Filename: toName,
-		},
-	})
+	proc := &internal.XdrProc_NFSPROC3_RENAME{Arg: &internal.RENAME3args{
+		From: diropargs(fhFrom, fromName),
+		To:   diropargs(fhTo, toName),
+	}}
 
+	err := v.callProc(context.Background(), proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
 	if err != nil {
 		log.Errorf("rename %s to %s fail %s", fromName, toName, err.Error())
 		return err
 	}
 
+	v.dirWcc(fhFrom, &proc.Res.Resok().Fromdir_wcc)
+	v.dirWcc(fhTo, &proc.Res.Resok().Todir_wcc)
+
+	// Whatever was cached under either name is elsewhere or gone now.
+	v.evictChild(fhFrom, fromName)
+	v.evictChild(fhTo, toName)
 	return nil
 }
+
+// Link creates newPath as a hard link to existing. It returns the attributes
+// of the file after the link was made, or nil if the server did not send
+// them, and the file's handle.
+func (v *Target) Link(existing, newPath string) (*Fattr, []byte, error) {
+	return v.LinkContext(context.Background(), existing, newPath)
+}
+
+// LinkContext is like Link, but gives up once ctx is done.
+func (v *Target) LinkContext(ctx context.Context, existing, newPath string) (*Fattr, []byte, error) {
+	var (
+		attr *Fattr
+		fh   []byte
+	)
+	err := v.retryStale(func() (err error) {
+		attr, fh, err = v.link(ctx, existing, newPath)
+		return err
+	}, existing, filepath.Dir(newPath))
+
+	return attr, fh, err
+}
+
+func (v *Target) link(ctx context.Context, existing, newPath string) (*Fattr, []byte, error) {
+	_, fh, err := v.lookup2(ctx, existing)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dir, name := filepath.Split(newPath)
+	_, dirfh, err := v.lookup2(ctx, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proc := &internal.XdrProc_NFSPROC3_LINK{Arg: &internal.LINK3args{
+		File: internal.Nfs_fh3{Data: fh},
+		Link: diropargs(dirfh, name),
+	}}
+
+	err = v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
+	if err != nil {
+		log.Debugf("link(%s, %s): %s", existing, newPath, err.Error())
+		return nil, nil, err
+	}
+
+	res := proc.Res.Resok()
+	v.dirWcc(dirfh, &res.Linkdir_wcc)
+	v.attrs.postOp(fh, &res.File_attributes)
+	attr := postOpAttrFrom(&res.File_attributes)
+	if !attr.IsSet {
+		return nil, fh, nil
+	}
+
+	return &attr.Attr, fh, nil
+}
+
+// Mknod creates a special file at path. ftype is one of NF3Chr, NF3Blk,
+// NF3Sock or NF3FIFO, and rdev the major and minor numbers of a device. It
+// returns the attributes of the new file, or nil if the server did not send
+// them, and its handle.
+func (v *Target) Mknod(path string, ftype uint32, perm os.FileMode, rdev [2]uint32) (*Fattr, []byte, error) {
+	return v.MknodContext(context.Background(), path, ftype, perm, rdev)
+}
+
+// MknodContext is like Mknod, but gives up once ctx is done.
+func (v *Target) MknodContext(ctx context.Context, path string, ftype uint32, perm os.FileMode, rdev [2]uint32) (*Fattr, []byte, error) {
+	var (
+		attr *Fattr
+		fh   []byte
+	)
+	err := v.retryStale(func() (err error) {
+		attr, fh, err = v.mknod(ctx, path, ftype, perm, rdev)
+		return err
+	}, filepath.Dir(path))
+
+	return attr, fh, err
+}
+
+func (v *Target) mknod(ctx context.Context, path string, ftype uint32, perm os.FileMode, rdev [2]uint32) (*Fattr, []byte, error) {
+	attrs := sattrTo(&Sattr3{
+		Mode: SetMode{
+			SetIt: true,
+			Mode:  uint32(perm.Perm()),
+		},
+	})
+
+	var what internal.Mknoddata3
+	what.Type = internal.Ftype3(ftype)
+	switch ftype {
+	case NF3Chr, NF3Blk:
+		*what.Device() = internal.Devicedata3{
+			Dev_attributes: attrs,
+			Spec:           internal.Specdata3{Specdata1: rdev[0], Specdata2: rdev[1]},
+		}
+	case NF3Sock, NF3FIFO:
+		*what.Pipe_attributes() = attrs
+	default:
+		return nil, nil, fmt.Errorf("mknod(%s): cannot create files of type %d", path, ftype)
+	}
+
+	dir, name := filepath.Split(path)
+	_, fh, err := v.lookup2(ctx, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proc := &internal.XdrProc_NFSPROC3_MKNOD{Arg: &internal.MKNOD3args{
+		Where: diropargs(fh, name),
+		What:  what,
+	}}
+
+	err = v.callProc(ctx, proc)
+	if err == nil {
+		err = nfsError(proc.Res.Status)
+	}
+	if err != nil {
+		log.Debugf("mknod(%s): %s", path, err.Error())
+		return nil, nil, err
+	}
+
+	res := proc.Res.Resok()
+	v.dirWcc(fh, &res.Dir_wcc)
+	obj := postOpFHFrom(&res.Obj)
+	if !obj.IsSet {
+		// The server may leave it to us to look the new file up.
+		return v.lookup(ctx, fh, name)
+	}
+	v.attrs.postOp(obj.FH, &res.Obj_attributes)
+
+	attr := postOpAttrFrom(&res.Obj_attributes)
+	if !attr.IsSet {
+		return nil, obj.FH, nil
+	}
+
+	return &attr.Attr, obj.FH, nil
+}
@@ -0,0 +1,522 @@
+package nfs3
+
+import (
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/nfs3/rpc"
+)
+
+func TestMkdirRevalidatesCachedHandle(t *testing.T) {
+	var (
+		s      = newNFSServer()
+		exists bool
+	)
+	s.handle(NFSProc3Mkdir, func(args io.Reader) internal.XdrType {
+		var a internal.MKDIR3args
+		decodeArgs(t, args, &a)
+
+		exists = true
+		res := &internal.MKDIR3res{}
+		res.Resok().Obj = postOpFH([]byte("dir"))
+		res.Resok().Obj_attributes = postOpAttr(internal.NF3DIR)
+		return res
+	})
+	s.handle(NFSProc3Getattr, func(args io.Reader) internal.XdrType {
+		var a internal.GETATTR3args
+		decodeArgs(t, args, &a)
+
+		res := &internal.GETATTR3res{}
+		if !exists {
+			res.Status = internal.NFS3ERR_STALE
+			return res
+		}
+		res.Resok().Obj_attributes = fattr(internal.NF3DIR)
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	mkdir := func() {
+		t.Helper()
+		if _, err := v.Mkdir("/dir", 0755); err != nil {
+			t.Fatalf("mkdir: %s", err)
+		}
+	}
+
+	mkdir()
+	mkdir()
+	if n, m := s.count(NFSProc3Mkdir), s.count(NFSProc3Getattr); n != 1 || m != 1 {
+		t.Errorf("existing directory: %d MKDIR, %d GETATTR, want 1 and 1", n, m)
+	}
+
+	// Removed behind the client's back, while its attributes are cached.
+	s.mu.Lock()
+	exists = false
+	s.mu.Unlock()
+
+	mkdir()
+	if n := s.count(NFSProc3Mkdir); n != 2 {
+		t.Errorf("removed directory: %d MKDIR, want 2", n)
+	}
+}
+
+func TestFileHandlesCached(t *testing.T) {
+	s := newNFSServer()
+	s.handleLookup(t, "dir")
+	s.handle(NFSProc3Getattr, func(args io.Reader) internal.XdrType {
+		var a internal.GETATTR3args
+		decodeArgs(t, args, &a)
+
+		res := &internal.GETATTR3res{}
+		res.Resok().Obj_attributes = fattr(internal.NF3REG)
+		return res
+	})
+	s.handle(NFSProc3Remove, func(args io.Reader) internal.XdrType {
+		var a internal.REMOVE3args
+		decodeArgs(t, args, &a)
+		return &internal.REMOVE3res{}
+	})
+
+	v := newTestTarget(t, s.Server)
+	expect := func(what string, lookups, getattrs int) {
+		t.Helper()
+		if n, m := s.count(NFSProc3Lookup), s.count(NFSProc3Getattr); n != lookups || m != getattrs {
+			t.Errorf("%s: %d LOOKUP, %d GETATTR, want %d and %d", what, n, m, lookups, getattrs)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if attr, err := v.Getattr("/dir/file"); err != nil || attr.IsDir() {
+			t.Fatalf("getattr: %v, %v", attr, err)
+		}
+	}
+	expect("getattr", 2, 0)
+
+	// Opening confirms the cached handle with the server.
+	if f, err := v.Open("/dir/file"); err != nil || string(f.fh) != "root/dir/file" {
+		t.Fatalf("open: %v", err)
+	}
+	expect("open", 2, 1)
+
+	if err := v.Remove("/dir/file"); err != nil {
+		t.Fatalf("remove: %s", err)
+	}
+	if _, err := v.Getattr("/dir/file"); err != nil {
+		t.Fatalf("getattr after remove: %s", err)
+	}
+	expect("getattr after remove", 3, 1)
+}
+
+func TestStaleHandleEvictsSubtree(t *testing.T) {
+	var (
+		s     = newNFSServer()
+		stale int
+	)
+	s.handleLookup(t, "dir", "sub", "other")
+	s.handle(NFSProc3Create, func(args io.Reader) internal.XdrType {
+		var a internal.CREATE3args
+		decodeArgs(t, args, &a)
+
+		if stale > 0 {
+			stale--
+			return &internal.CREATE3res{Status: internal.NFS3ERR_STALE}
+		}
+		return created()
+	})
+
+	v := newTestTarget(t, s.Server)
+	for _, p := range []string{"/dir/sub/file", "/other/file"} {
+		if _, err := v.Getattr(p); err != nil {
+			t.Fatalf("getattr %s: %s", p, err)
+		}
+	}
+	if n := s.count(NFSProc3Lookup); n != 5 {
+		t.Fatalf("%d LOOKUP, want 5", n)
+	}
+
+	s.mu.Lock()
+	stale = 1
+	s.mu.Unlock()
+
+	if _, err := v.Create("/dir/new", 0644); err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	if n, m := s.count(NFSProc3Create), s.count(NFSProc3Lookup); n != 2 || m != 6 {
+		t.Errorf("stale once: %d CREATE, %d LOOKUP, want 2 and 6", n, m)
+	}
+	for p, want := range map[string]bool{
+		"/dir":          true, // looked up again
+		"/dir/sub":      false,
+		"/dir/sub/file": false,
+		"/other":        true,
+		"/other/file":   true,
+		"/dir/new":      true,
+	} {
+		if _, ok := v.cachedFH(p); ok != want {
+			t.Errorf("%s cached: %t, want %t", p, ok, want)
+		}
+	}
+
+	// Stale again after looking the directory up afresh: given up on.
+	s.mu.Lock()
+	stale = 100
+	s.mu.Unlock()
+
+	if _, err := v.Create("/dir/new", 0644); !IsStaleError(err) {
+		t.Errorf("create: got %v, want NFS3ERR_STALE", err)
+	}
+	if n, m := s.count(NFSProc3Create), s.count(NFSProc3Lookup); n != 4 || m != 7 {
+		t.Errorf("always stale: %d CREATE, %d LOOKUP, want 4 and 7", n, m)
+	}
+}
+
+func TestFileHandleExpires(t *testing.T) {
+	var (
+		s       = newNFSServer()
+		current = "old"
+		chmoded []string
+	)
+	s.handle(NFSProc3Lookup, func(args io.Reader) internal.XdrType {
+		var a internal.LOOKUP3args
+		decodeArgs(t, args, &a)
+
+		res := &internal.LOOKUP3res{}
+		res.Resok().Object.Data = []byte(current)
+		res.Resok().Obj_attributes = postOpAttr(internal.NF3REG)
+		return res
+	})
+	s.handle(NFSProc3Setattr, func(args io.Reader) internal.XdrType {
+		var a internal.SETATTR3args
+		decodeArgs(t, args, &a)
+
+		chmoded = append(chmoded, string(a.Object.Data))
+		return &internal.SETATTR3res{}
+	})
+
+	v := newTestTarget(t, s.Server)
+	const ttl = 200 * time.Millisecond
+	v.SetAttrCache(&AttrCacheOptions{RegMin: ttl, RegMax: ttl, DirMin: time.Hour, DirMax: time.Hour})
+
+	chmod := func() {
+		t.Helper()
+		if err := v.Chmod("/file", 0644); err != nil {
+			t.Fatalf("chmod: %s", err)
+		}
+	}
+
+	chmod()
+	// Another client renames a file over the path.
+	s.mu.Lock()
+	current = "new"
+	s.mu.Unlock()
+	chmod()
+	time.Sleep(2 * ttl)
+	chmod()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if want := []string{"old", "old", "new"}; !reflect.DeepEqual(chmoded, want) {
+		t.Errorf("SETATTR sent to %q, want %q", chmoded, want)
+	}
+}
+
+// created returns the reply to a CREATE that made the regular file
+// root/file.
+func created() *internal.CREATE3res {
+	res := &internal.CREATE3res{}
+	res.Resok().Obj = postOpFH([]byte("root/file"))
+	res.Resok().Obj_attributes = postOpAttr(internal.NF3REG)
+	return res
+}
+
+// handleSetattr answers SETATTR, recording the attributes set.
+func (s *nfsServer) handleSetattr(t *testing.T, sattrs *[]internal.Sattr3) {
+	s.handle(NFSProc3Setattr, func(args io.Reader) internal.XdrType {
+		var a internal.SETATTR3args
+		decodeArgs(t, args, &a)
+
+		*sattrs = append(*sattrs, a.New_attributes)
+		return &internal.SETATTR3res{}
+	})
+}
+
+func TestCreateExclusiveRetriesWithSameVerifier(t *testing.T) {
+	const timeout = 100 * time.Millisecond
+
+	var (
+		s      = newNFSServer()
+		hows   []internal.Createhow3
+		sattrs []internal.Sattr3
+	)
+	// Registered directly so that the first call can be held up without
+	// holding up the retry.
+	s.Register(Nfs3Prog, Nfs3Vers, NFSProc3Create, func(call *rpc.Call, args io.Reader) (interface{}, error) {
+		var a internal.CREATE3args
+		decodeArgs(t, args, &a)
+
+		s.mu.Lock()
+		hows = append(hows, a.How)
+		n := len(hows)
+		s.mu.Unlock()
+
+		if n == 1 {
+			// The file is created, but the reply comes too late.
+			time.Sleep(3 * timeout)
+		}
+		return reply(created()), nil
+	})
+	s.handleSetattr(t, &sattrs)
+
+	v := newTestTarget(t, s.Server)
+	v.Client.SetTimeout(timeout)
+
+	if fh, err := v.CreateExclusive("/file", 0640); err != nil || string(fh) != "root/file" {
+		t.Fatalf("create: %q, %v", fh, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(hows) != 2 {
+		t.Fatalf("sent %d CREATE, want 2", len(hows))
+	}
+	for i, how := range hows {
+		if how.Mode != internal.EXCLUSIVE {
+			t.Errorf("CREATE %d: mode %s, want EXCLUSIVE", i, how.Mode)
+		}
+	}
+	if *hows[0].Verf() != *hows[1].Verf() {
+		t.Errorf("retry sent verifier %x, want %x", *hows[1].Verf(), *hows[0].Verf())
+	}
+	if len(sattrs) != 1 {
+		t.Errorf("sent %d SETATTR, want 1", len(sattrs))
+	}
+}
+
+func TestCreateExclusiveFallsBackToGuarded(t *testing.T) {
+	var (
+		s      = newNFSServer()
+		hows   []internal.Createhow3
+		sattrs []internal.Sattr3
+	)
+	s.handle(NFSProc3Create, func(args io.Reader) internal.XdrType {
+		var a internal.CREATE3args
+		decodeArgs(t, args, &a)
+
+		hows = append(hows, a.How)
+		if a.How.Mode == internal.EXCLUSIVE {
+			return &internal.CREATE3res{Status: internal.NFS3ERR_NOTSUPP}
+		}
+		return created()
+	})
+	s.handleSetattr(t, &sattrs)
+
+	v := newTestTarget(t, s.Server)
+	if fh, err := v.CreateExclusive("/file", 0640); err != nil || string(fh) != "root/file" {
+		t.Fatalf("create: %q, %v", fh, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(hows) != 2 || hows[0].Mode != internal.EXCLUSIVE || hows[1].Mode != internal.GUARDED {
+		t.Fatalf("sent CREATE %v, want EXCLUSIVE then GUARDED", hows)
+	}
+	if mode := hows[1].Obj_attributes().Mode; !mode.Set_it || *mode.Mode() != 0640 {
+		t.Errorf("guarded create: mode %v, want 0640", mode)
+	}
+	// A guarded create sets the mode itself.
+	if len(sattrs) != 0 {
+		t.Errorf("sent %d SETATTR, want none", len(sattrs))
+	}
+}
+
+func TestCreateExclusiveSetsAttributes(t *testing.T) {
+	var (
+		s      = newNFSServer()
+		sattrs []internal.Sattr3
+	)
+	s.handle(NFSProc3Create, func(args io.Reader) internal.XdrType {
+		var a internal.CREATE3args
+		decodeArgs(t, args, &a)
+		return created()
+	})
+	s.handleSetattr(t, &sattrs)
+
+	v := newTestTarget(t, s.Server)
+	if _, err := v.CreateExclusive("/file", 0640); err != nil {
+		t.Fatalf("create: %s", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(sattrs) != 1 {
+		t.Fatalf("sent %d SETATTR, want 1", len(sattrs))
+	}
+	// The times held the verifier, and are set back to the server's.
+	a := sattrs[0]
+	if !a.Mode.Set_it || *a.Mode.Mode() != 0640 {
+		t.Errorf("mode %v, want 0640", a.Mode)
+	}
+	if a.Atime.Set_it != internal.SET_TO_SERVER_TIME || a.Mtime.Set_it != internal.SET_TO_SERVER_TIME {
+		t.Errorf("atime %s, mtime %s, want both SET_TO_SERVER_TIME", a.Atime.Set_it, a.Mtime.Set_it)
+	}
+}
+
+func TestStatFS(t *testing.T) {
+	s := newNFSServer()
+	s.handle(NFSProc3FSStat, func(args io.Reader) internal.XdrType {
+		var a internal.FSSTAT3args
+		decodeArgs(t, args, &a)
+		if string(a.Fsroot.Data) != string(rootFH) {
+			t.Errorf("FSSTAT of %q, want %q", a.Fsroot.Data, rootFH)
+		}
+
+		res := &internal.FSSTAT3res{}
+		ok := res.Resok()
+		ok.Obj_attributes = postOpAttr(internal.NF3DIR)
+		ok.Tbytes, ok.Fbytes, ok.Abytes = 1<<40, 1<<30, 1<<29
+		ok.Tfiles, ok.Ffiles, ok.Afiles = 1000, 100, 10
+		ok.Invarsec = 7
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	st, err := v.StatFS()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := FSStat{
+		TotalBytes: 1 << 40, FreeBytes: 1 << 30, AvailBytes: 1 << 29,
+		TotalFiles: 1000, FreeFiles: 100, AvailFiles: 10,
+		Invarsec: 7,
+	}
+	if !st.Attr.IsSet || !st.Attr.Attr.IsDir() {
+		t.Errorf("attributes %+v, want those of the root directory", st.Attr)
+	}
+	st.Attr = PostOpAttr{}
+	if *st != want {
+		t.Errorf("got %+v, want %+v", *st, want)
+	}
+}
+
+func TestPathConf(t *testing.T) {
+	s := newNFSServer()
+	s.handleLookup(t, "dir")
+	s.handle(NFSProc3PathConf, func(args io.Reader) internal.XdrType {
+		var a internal.PATHCONF3args
+		decodeArgs(t, args, &a)
+		if string(a.Object.Data) != "root/dir/file" {
+			t.Errorf("PATHCONF of %q, want root/dir/file", a.Object.Data)
+		}
+
+		res := &internal.PATHCONF3res{}
+		ok := res.Resok()
+		ok.Linkmax, ok.Name_max = 32000, 255
+		ok.No_trunc, ok.Case_preserving = true, true
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	pc, err := v.PathConf("/dir/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := PathConf{LinkMax: 32000, NameMax: 255, NoTrunc: true, CasePreserving: true}
+	if *pc != want {
+		t.Errorf("got %+v, want %+v", *pc, want)
+	}
+}
+
+func TestLink(t *testing.T) {
+	var (
+		s    = newNFSServer()
+		args []internal.LINK3args
+	)
+	s.handleLookup(t, "dir")
+	s.handle(NFSProc3Link, func(r io.Reader) internal.XdrType {
+		var a internal.LINK3args
+		decodeArgs(t, r, &a)
+		args = append(args, a)
+
+		res := &internal.LINK3res{}
+		res.Resok().File_attributes = postOpAttr(internal.NF3REG)
+		res.Resok().File_attributes.Attributes().Nlink = 2
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	attr, fh, err := v.Link("/file", "/dir/hard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(fh) != "root/file" || attr == nil || attr.Nlink != 2 {
+		t.Errorf("got %q, %+v, want the handle and attributes of root/file", fh, attr)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(args) != 1 {
+		t.Fatalf("sent %d LINK, want 1", len(args))
+	}
+	if a := args[0]; string(a.File.Data) != "root/file" || string(a.Link.Dir.Data) != "root/dir" || a.Link.Name != "hard" {
+		t.Errorf("LINK %q to %q in %q, want root/file to hard in root/dir", a.File.Data, a.Link.Name, a.Link.Dir.Data)
+	}
+}
+
+func TestMknod(t *testing.T) {
+	var (
+		s     = newNFSServer()
+		whats []internal.Mknoddata3
+	)
+	s.handleLookup(t, "dev")
+	s.handle(NFSProc3Mknod, func(r io.Reader) internal.XdrType {
+		var a internal.MKNOD3args
+		decodeArgs(t, r, &a)
+		whats = append(whats, a.What)
+
+		res := &internal.MKNOD3res{}
+		if a.What.Type == internal.NF3CHR {
+			// Other types leave the client to look the new file up.
+			res.Resok().Obj = postOpFH(append(append(a.Where.Dir.Data, '/'), a.Where.Name...))
+			res.Resok().Obj_attributes = postOpAttr(a.What.Type)
+		}
+		return res
+	})
+
+	v := newTestTarget(t, s.Server)
+	if _, fh, err := v.Mknod("/dev/tty", NF3Chr, 0620, [2]uint32{136, 3}); err != nil || string(fh) != "root/dev/tty" {
+		t.Errorf("character device: %q, %v", fh, err)
+	}
+	if _, fh, err := v.Mknod("/dev/fifo", NF3FIFO, 0600, [2]uint32{}); err != nil || string(fh) != "root/dev/fifo" {
+		t.Errorf("fifo: %q, %v", fh, err)
+	}
+	if _, _, err := v.Mknod("/dev/file", NF3Reg, 0600, [2]uint32{}); err == nil {
+		t.Error("mknod of a regular file succeeded")
+	}
+	// The dev directory, then the fifo the server did not return.
+	if n := s.count(NFSProc3Lookup); n != 2 {
+		t.Errorf("%d LOOKUP, want 2", n)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(whats) != 2 {
+		t.Fatalf("sent %d MKNOD, want 2", len(whats))
+	}
+	if dev := whats[0].Device(); dev.Spec != (internal.Specdata3{Specdata1: 136, Specdata2: 3}) || *dev.Dev_attributes.Mode.Mode() != 0620 {
+		t.Errorf("character device: rdev %+v, mode %o, want 136,3 and 0620", dev.Spec, *dev.Dev_attributes.Mode.Mode())
+	}
+	if attrs := whats[1].Pipe_attributes(); *attrs.Mode.Mode() != 0600 {
+		t.Errorf("fifo: mode %o, want 0600", *attrs.Mode.Mode())
+	}
+}
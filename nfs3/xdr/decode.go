@@ -26,12 +26,19 @@ func ReadOpaque(r io.Reader) ([]byte, error) {
 		return nil, err
 	}
 
-	buf := make([]byte, length)
-	if _, err = r.Read(buf); err != nil {
+	// Refuse lengths longer than what is left of a buffered message instead
+	// of allocating whatever a corrupt length field asks for.
+	if l, ok := r.(interface{ Len() int }); ok && int64(length) > int64(l.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	// opaque data is padded to a multiple of four bytes
+	buf := make([]byte, (length+3)&^3)
+	if _, err = io.ReadFull(r, buf); err != nil {
 		return nil, err
 	}
 
-	return buf, nil
+	return buf[:length], nil
 }
 
 func ReadUint32List(r io.Reader) ([]uint32, error) {
@@ -40,6 +47,10 @@ func ReadUint32List(r io.Reader) ([]uint32, error) {
 		return nil, err
 	}
 
+	if l, ok := r.(interface{ Len() int }); ok && int64(length)*4 > int64(l.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
 	buf := make([]uint32, length)
 
 	for i := 0; i < int(length); i++ {
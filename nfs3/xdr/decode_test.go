@@ -0,0 +1,63 @@
+package xdr
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReadOpaque(t *testing.T) {
+	for _, tt := range []struct {
+		data []byte
+		want []byte
+	}{
+		{[]byte{0, 0, 0, 0}, []byte{}},
+		{[]byte{0, 0, 0, 1, 'a', 0, 0, 0}, []byte("a")},
+		{[]byte{0, 0, 0, 4, 'a', 'b', 'c', 'd'}, []byte("abcd")},
+		{[]byte{0, 0, 0, 5, 'a', 'b', 'c', 'd', 'e', 0, 0, 0}, []byte("abcde")},
+	} {
+		// A reader returning a byte at a time checks that short reads are
+		// retried, and the trailing word that the padding is consumed.
+		r := iotest.OneByteReader(io.MultiReader(bytes.NewReader(tt.data), bytes.NewReader([]byte{0, 0, 0, 9})))
+
+		got, err := ReadOpaque(r)
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("ReadOpaque(% x) = %q, %v, want %q", tt.data, got, err, tt.want)
+			continue
+		}
+
+		if next, err := ReadUint32(r); err != nil || next != 9 {
+			t.Errorf("ReadOpaque(% x) left the stream at %d, %v", tt.data, next, err)
+		}
+	}
+}
+
+func TestReadOpaqueBounds(t *testing.T) {
+	for _, data := range [][]byte{
+		{0xff, 0xff, 0xff, 0xff},
+		{0, 0, 0, 8, 'a', 'b', 'c', 'd'},
+		{0, 0, 0, 3, 'a', 'b', 'c'},
+	} {
+		if got, err := ReadOpaque(bytes.NewReader(data)); !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("ReadOpaque(% x) = %q, %v, want io.ErrUnexpectedEOF", data, got, err)
+		}
+	}
+}
+
+func TestReadUint32List(t *testing.T) {
+	got, err := ReadUint32List(bytes.NewReader([]byte{0, 0, 0, 2, 0, 0, 0, 1, 0, 0, 1, 0}))
+	if err != nil || len(got) != 2 || got[0] != 1 || got[1] != 256 {
+		t.Errorf("ReadUint32List = %v, %v, want [1 256]", got, err)
+	}
+
+	for _, data := range [][]byte{
+		{0x40, 0, 0, 0},
+		{0, 0, 0, 2, 0, 0, 0, 1},
+	} {
+		if got, err := ReadUint32List(bytes.NewReader(data)); !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("ReadUint32List(% x) = %v, %v, want io.ErrUnexpectedEOF", data, got, err)
+		}
+	}
+}
@@ -10,3 +10,30 @@ func Write(w io.Writer, val interface{}) error {
 	_, err := xdr.Marshal(w, val)
 	return err
 }
+
+// The Append functions encode by hand for hot paths where the reflection in
+// Write costs too much.
+
+func AppendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func AppendUint64(b []byte, v uint64) []byte {
+	return AppendUint32(AppendUint32(b, uint32(v>>32)), uint32(v))
+}
+
+// AppendOpaque appends variable-length opaque data, including its length and
+// padding.
+func AppendOpaque(b, data []byte) []byte {
+	b = AppendUint32(b, uint32(len(data)))
+	b = append(b, data...)
+	return append(b, Padding(len(data))...)
+}
+
+var zeros [4]byte
+
+// Padding returns the zero bytes that follow n bytes of opaque data to align
+// it to four bytes.
+func Padding(n int) []byte {
+	return zeros[:(4-n%4)%4]
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	. "github.com/aobco/nfs/internal"
+	"github.com/aobco/nfs/metrics"
 	"io"
 	"math"
 	"net"
@@ -18,6 +19,22 @@ import (
 
 const NfsReadBlockLen = 512 * 1024
 
+// Record marking (RFC 5531 section 11): every fragment is preceded by a four
+// byte header holding its length, with the high bit set on the last fragment
+// of a record.
+const (
+	lastFragment  = 0x80000000
+	fragmentSizes = 0x7fffffff
+)
+
+// DefaultMaxRecordSize caps the size of a reassembled reply record. A reply
+// larger than this is treated as a protocol error rather than allocated.
+var DefaultMaxRecordSize = 16 << 20
+
+// DefaultMaxFragmentSize is the largest fragment sent; longer messages are
+// split into several fragments. Zero sends every message as one fragment.
+var DefaultMaxFragmentSize = 0
+
 var standardNfsAttrs = Bitmap4{
 	1<<FATTR4_TYPE | 1<<FATTR4_SIZE,
 	1 << (FATTR4_TIME_MODIFY - 32),
@@ -52,6 +69,15 @@ type NfsClient struct {
 	openConfirmed bool
 
 	rootFh Nfs_fh4
+
+	maxRecordSize   int
+	maxFragmentSize int
+
+	observer metrics.Observer
+
+	// running byte counters, the observer reports per-call deltas
+	bytesSent     int
+	bytesReceived int
 }
 
 var _ NfsInterface = &NfsClient{}
@@ -96,6 +122,9 @@ func NewNfsClientWithConn(conn net.Conn, auth AuthParams) (*NfsClient, error) {
 		authData: makeAuthData(auth),
 		nfsSeqId: 0,
 		clientId: clientId,
+
+		maxRecordSize:   DefaultMaxRecordSize,
+		maxFragmentSize: DefaultMaxFragmentSize,
 	}
 	cl := NewCleanup(cli.Close)
 	defer cl.Cleanup()
@@ -121,6 +150,53 @@ func (c *NfsClient) Close() {
 	_ = c.conn.Close()
 }
 
+// SetObserver installs an observer that is told about every call the client
+// makes. Pass nil to remove it.
+func (c *NfsClient) SetObserver(o metrics.Observer) {
+	c.observer = o
+}
+
+// observe reports a finished call to the observer, if any. `sent` and
+// `received` are the byte counters from before the call.
+func (c *NfsClient) observe(proc XdrProc, ops []Nfs_argop4, start time.Time, sent, received int,
+	status *Nfsstat4, err error) {
+
+	if c.observer == nil {
+		return
+	}
+
+	ev := &metrics.Event{
+		Prog:          proc.Prog(),
+		Vers:          proc.Vers(),
+		Proc:          proc.Proc(),
+		BytesSent:     c.bytesSent - sent,
+		BytesReceived: c.bytesReceived - received,
+		Duration:      time.Since(start),
+		Err:           err,
+	}
+	for _, op := range ops {
+		ev.Ops = append(ev.Ops, strings.TrimPrefix(op.Argop.String(), "OP_"))
+	}
+	if status != nil {
+		ev.Status = uint32(*status)
+		ev.HasStatus = true
+	}
+
+	c.observer.ObserveCall(ev)
+}
+
+// SetMaxRecordSize changes the largest reply record accepted. Zero removes
+// the limit.
+func (c *NfsClient) SetMaxRecordSize(n int) {
+	c.maxRecordSize = n
+}
+
+// SetMaxFragmentSize makes the client split messages longer than n bytes into
+// several record fragments. Zero sends every message whole.
+func (c *NfsClient) SetMaxFragmentSize(n int) {
+	c.maxFragmentSize = n
+}
+
 func makeAuthData(auth AuthParams) []byte {
 	machName := auth.MachineName
 	if len(machName) > 255 {
@@ -185,30 +261,78 @@ func (c *NfsClient) sendMessage(proc XdrProc) (xid uint32, err error) {
 		out.Marshal("", proc.GetArg())
 	}
 
-	// Yep, the RPC protocol requires this strange OR
-	err = binary.Write(c.conn, binary.BigEndian, 0x80000000|uint32(buffer.Len()))
-	if err != nil {
-		return
+	err = c.writeRecord(buffer.Bytes())
+	return
+}
+
+// writeRecord sends msg as one record, split into fragments of at most
+// maxFragmentSize bytes.
+func (c *NfsClient) writeRecord(msg []byte) error {
+	frag := c.maxFragmentSize
+	if frag <= 0 || frag > fragmentSizes {
+		frag = fragmentSizes
 	}
-	_, err = c.conn.Write(buffer.Bytes())
-	if err != nil {
-		return
+
+	out := make([]byte, 0, len(msg)+4*(len(msg)/frag+1))
+	for off := 0; ; {
+		n := len(msg) - off
+		if n > frag {
+			n = frag
+		}
+
+		// Yep, the RPC protocol requires this strange OR on the last fragment
+		hdr := uint32(n)
+		if off+n == len(msg) {
+			hdr |= lastFragment
+		}
+
+		out = append(out, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(out[len(out)-4:], hdr)
+		out = append(out, msg[off:off+n]...)
+
+		if off += n; off == len(msg) {
+			break
+		}
 	}
 
-	return
+	n, err := c.conn.Write(out)
+	c.bytesSent += n
+	return err
 }
 
-func (c *NfsClient) readNfsMessage(result XdrType) (xid uint32, err error) {
+// readRecord reads fragments until the last one of a record and returns the
+// reassembled record.
+func (c *NfsClient) readRecord() ([]byte, error) {
+	var msgBuf []byte
 	lenBuf := make([]byte, 4)
-	_, err = io.ReadFull(c.conn, lenBuf)
-	if err != nil {
-		return
+	for {
+		_, err := io.ReadFull(c.conn, lenBuf)
+		if err != nil {
+			return nil, err
+		}
+		hdr := binary.BigEndian.Uint32(lenBuf)
+		fragLen := int(hdr & fragmentSizes)
+
+		if c.maxRecordSize > 0 && len(msgBuf)+fragLen > c.maxRecordSize {
+			return nil, fmt.Errorf("RPC record exceeds maximum size of %d bytes", c.maxRecordSize)
+		}
+
+		n := len(msgBuf)
+		msgBuf = append(msgBuf, make([]byte, fragLen)...)
+		_, err = io.ReadFull(c.conn, msgBuf[n:])
+		if err != nil {
+			return nil, err
+		}
+		c.bytesReceived += 4 + fragLen
+
+		if hdr&lastFragment != 0 {
+			return msgBuf, nil
+		}
 	}
-	// The RPC protocol sets the MSB to 1 for length fields. Don't ask me why.
-	msgLen := binary.BigEndian.Uint32(lenBuf) & 0x7fffffff
+}
 
-	msgBuf := make([]byte, msgLen)
-	_, err = io.ReadFull(c.conn, msgBuf)
+func (c *NfsClient) readNfsMessage(result XdrType) (xid uint32, err error) {
+	msgBuf, err := c.readRecord()
 	if err != nil {
 		return
 	}
@@ -269,9 +393,14 @@ func (c *NfsClient) getRpcError(m *Rpc_msg) string {
 	return "Invalid reply_stat"
 }
 
-func (c *NfsClient) Ping() error {
+func (c *NfsClient) Ping() (err error) {
 	nullProc := XdrProc_NFSPROC4_NULL{}
 
+	start, sent, received := time.Now(), c.bytesSent, c.bytesReceived
+	defer func() {
+		c.observe(&nullProc, nil, start, sent, received, nil, err)
+	}()
+
 	xid, err := c.sendMessage(&nullProc)
 	if err != nil {
 		return err
@@ -287,12 +416,18 @@ func (c *NfsClient) Ping() error {
 	return nil
 }
 
-func (c *NfsClient) runNfsTransaction(ops []Nfs_argop4, pathHint string) ([]Nfs_resop4, error) {
+func (c *NfsClient) runNfsTransaction(ops []Nfs_argop4, pathHint string) (_ []Nfs_resop4, err error) {
 	compound := XdrProc_NFSPROC4_COMPOUND{}
 
 	args := compound.GetArg().(*COMPOUND4args)
 	args.Argarray = ops
 
+	start, sent, received := time.Now(), c.bytesSent, c.bytesReceived
+	var status *Nfsstat4
+	defer func() {
+		c.observe(&compound, ops, start, sent, received, status, err)
+	}()
+
 	xid, err := c.sendMessage(&compound)
 	if err != nil {
 		return nil, err
@@ -306,6 +441,7 @@ func (c *NfsClient) runNfsTransaction(ops []Nfs_argop4, pathHint string) ([]Nfs_
 	}
 
 	res := compound.GetRes().(*COMPOUND4res)
+	status = &res.Status
 	// TODO: translate the error better
 	if res.Status != NFS4_OK {
 		return nil, &NfsError{
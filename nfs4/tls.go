@@ -0,0 +1,124 @@
+package nfs4
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	. "github.com/aobco/nfs/internal"
+	"net"
+)
+
+// The credential flavor of the NULL call that asks the server to switch the
+// connection to TLS, and the verifier it answers with (RFC 9289).
+const (
+	authTLS          Auth_flavor = 7
+	startTLSVerifier             = "STARTTLS"
+)
+
+var ErrTLSUnsupported = errors.New("server does not support RPC-over-TLS")
+
+// Create the NFS client like NewNfsClient, but encrypt the connection with
+// RPC-over-TLS. See StartTLS for how `config` is used.
+func NewNfsClientTLS(ctx context.Context, server string, auth AuthParams,
+	config *tls.Config) (*NfsClient, error) {
+
+	d := net.Dialer{}
+	conn, err := d.DialContext(ctx, "tcp", server)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConn, err := StartTLS(conn, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return NewNfsClientWithConn(tlsConn, auth)
+}
+
+// StartTLS probes the server with an AUTH_TLS NULL call and performs the TLS
+// handshake once it answers STARTTLS. The returned connection is meant for
+// NewNfsClientWithConn; `conn` may itself be a SupervisedConnection, in which
+// case cancelling its context also interrupts the TLS session.
+// `config` may carry client certificates and root CAs. The "sunrpc" ALPN
+// protocol and the server name are filled in when missing.
+func StartTLS(conn net.Conn, config *tls.Config) (*tls.Conn, error) {
+	if config == nil {
+		config = &tls.Config{}
+	}
+	config = config.Clone()
+
+	if len(config.NextProtos) == 0 {
+		config.NextProtos = []string{"sunrpc"}
+	}
+
+	if config.ServerName == "" && !config.InsecureSkipVerify {
+		if host, _, err := net.SplitHostPort(conn.RemoteAddr().String()); err == nil {
+			config.ServerName = host
+		}
+	}
+
+	probe := &NfsClient{
+		conn:          conn,
+		authType:      authTLS,
+		maxRecordSize: 4096,
+	}
+	err := probe.probeTLS()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConn := tls.Client(conn, config)
+	err = tlsConn.Handshake()
+	if err != nil {
+		return nil, err
+	}
+
+	return tlsConn, nil
+}
+
+func (c *NfsClient) probeTLS() (err error) {
+	nullProc := XdrProc_NFSPROC4_NULL{}
+
+	xid, err := c.sendMessage(&nullProc)
+	if err != nil {
+		return err
+	}
+
+	msgBuf, err := c.readRecord()
+	if err != nil {
+		return err
+	}
+
+	// The unmarshaller for some reason loves to panic. Sigh.
+	defer func() {
+		if i := recover(); i != nil {
+			if e, ok := i.(XdrError); ok {
+				err = e
+			} else {
+				panic(i)
+			}
+		}
+	}()
+
+	reply := Rpc_msg{}
+	in := XdrIn{In: bytes.NewReader(msgBuf)}
+	in.Marshal("", &reply)
+
+	if reply.Xid != xid {
+		return fmt.Errorf("mismathced xids: %d and %d", xid, reply.Xid)
+	}
+
+	if !c.isRpcSuccess(&reply) {
+		return fmt.Errorf("%w: %s", ErrTLSUnsupported, c.getRpcError(&reply))
+	}
+
+	if string(reply.Body.Rbody().Areply().Verf.Body) != startTLSVerifier {
+		return ErrTLSUnsupported
+	}
+
+	return nil
+}
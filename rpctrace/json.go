@@ -0,0 +1,47 @@
+package rpctrace
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONWriter writes one JSON object per record and line. Data is base64
+// encoded.
+type JSONWriter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func NewJSONWriter(w io.Writer) *JSONWriter {
+	return &JSONWriter{enc: json.NewEncoder(w)}
+}
+
+func (w *JSONWriter) WriteRecord(r *Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.enc.Encode(r)
+}
+
+// ReadJSON reads records written by a JSONWriter.
+func ReadJSON(r io.Reader) ([]Record, error) {
+	var records []Record
+
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 64<<20)
+	for s.Scan() {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+
+		var rec Record
+		if err := json.Unmarshal(s.Bytes(), &rec); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+
+	return records, s.Err()
+}
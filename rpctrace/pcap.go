@@ -0,0 +1,278 @@
+package rpctrace
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	pcapMagic      = 0xa1b2c3d4
+	pcapMagicNano  = 0xa1b23c4d
+	linkTypeRaw    = 101 // raw IPv4/IPv6, no link-layer header
+	linkTypeEther  = 1
+	etherHeaderLen = 14
+	ipHeaderLen    = 20
+	tcpHeaderLen   = 20
+	maxSegmentLen  = 65535 - ipHeaderLen - tcpHeaderLen
+
+	// maxPacketLen bounds the packets ReadPcap accepts, whatever snapshot
+	// length the file claims, so that a corrupt length cannot make it
+	// allocate without limit.
+	maxPacketLen = 256 << 10
+)
+
+// PcapWriter writes records as synthetic IPv4/TCP segments, record marker
+// included, so that tools like Wireshark dissect them as ONC RPC. Only the
+// payload is real; addresses are taken from the wrapped connection when they
+// are IPv4 and made up otherwise.
+type PcapWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	err     error
+	started bool
+
+	client, server       net.IP
+	clientPort, srvPort  uint16
+	clientSeq, serverSeq uint32
+}
+
+func NewPcapWriter(w io.Writer) *PcapWriter {
+	return &PcapWriter{
+		w:          w,
+		client:     net.IPv4(10, 0, 0, 1).To4(),
+		server:     net.IPv4(10, 0, 0, 2).To4(),
+		clientPort: 1023,
+		srvPort:    2049,
+		clientSeq:  1,
+		serverSeq:  1,
+	}
+}
+
+func (w *PcapWriter) setAddrs(local, remote net.Addr) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if a, ok := local.(*net.TCPAddr); ok && a.IP.To4() != nil {
+		w.client, w.clientPort = a.IP.To4(), uint16(a.Port)
+	}
+	if a, ok := remote.(*net.TCPAddr); ok && a.IP.To4() != nil {
+		w.server, w.srvPort = a.IP.To4(), uint16(a.Port)
+	}
+}
+
+func (w *PcapWriter) WriteRecord(r *Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+
+	if !w.started {
+		hdr := make([]byte, 24)
+		binary.LittleEndian.PutUint32(hdr[0:], pcapMagic)
+		binary.LittleEndian.PutUint16(hdr[4:], 2)
+		binary.LittleEndian.PutUint16(hdr[6:], 4)
+		binary.LittleEndian.PutUint32(hdr[16:], 65535)
+		binary.LittleEndian.PutUint32(hdr[20:], linkTypeRaw)
+		if _, w.err = w.w.Write(hdr); w.err != nil {
+			return w.err
+		}
+		w.started = true
+	}
+
+	payload := make([]byte, 4+len(r.Data))
+	binary.BigEndian.PutUint32(payload, uint32(len(r.Data))|0x80000000)
+	copy(payload[4:], r.Data)
+
+	for len(payload) > 0 {
+		n := len(payload)
+		if n > maxSegmentLen {
+			n = maxSegmentLen
+		}
+		if w.err = w.writeSegment(r, payload[:n]); w.err != nil {
+			return w.err
+		}
+		payload = payload[n:]
+	}
+
+	return nil
+}
+
+func (w *PcapWriter) writeSegment(r *Record, payload []byte) error {
+	src, dst := w.client, w.server
+	sport, dport := w.clientPort, w.srvPort
+	seq, ack := &w.clientSeq, w.serverSeq
+	if r.Direction == Recv {
+		src, dst = dst, src
+		sport, dport = dport, sport
+		seq, ack = &w.serverSeq, w.clientSeq
+	}
+
+	total := ipHeaderLen + tcpHeaderLen + len(payload)
+	pkt := make([]byte, 16+total)
+
+	usec := r.Time.UnixNano() / 1000
+	binary.LittleEndian.PutUint32(pkt[0:], uint32(usec/1e6))
+	binary.LittleEndian.PutUint32(pkt[4:], uint32(usec%1e6))
+	binary.LittleEndian.PutUint32(pkt[8:], uint32(total))
+	binary.LittleEndian.PutUint32(pkt[12:], uint32(total))
+
+	ip := pkt[16:]
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:], uint16(total))
+	ip[8] = 64 // TTL
+	ip[9] = 6  // TCP
+	copy(ip[12:], src)
+	copy(ip[16:], dst)
+	binary.BigEndian.PutUint16(ip[10:], checksum(ip[:ipHeaderLen]))
+
+	tcp := ip[ipHeaderLen:]
+	binary.BigEndian.PutUint16(tcp[0:], sport)
+	binary.BigEndian.PutUint16(tcp[2:], dport)
+	binary.BigEndian.PutUint32(tcp[4:], *seq)
+	binary.BigEndian.PutUint32(tcp[8:], ack)
+	tcp[12] = (tcpHeaderLen / 4) << 4
+	tcp[13] = 0x18 // PSH|ACK
+	binary.BigEndian.PutUint16(tcp[14:], 65535)
+	copy(tcp[tcpHeaderLen:], payload)
+
+	*seq += uint32(len(payload))
+
+	_, err := w.w.Write(pkt)
+	return err
+}
+
+func checksum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	for sum > 0xffff {
+		sum = sum>>16 + sum&0xffff
+	}
+	return ^uint16(sum)
+}
+
+// ReadPcap reads back the records of a file written by PcapWriter, for
+// NewReplayConn. Captures taken by other tools can be read too, if they hold
+// a single IPv4 TCP connection, raw or over Ethernet, without retransmitted
+// or reordered segments: records are reassembled from the TCP payloads in the
+// order they appear. The client is the sender of the first segment.
+func ReadPcap(r io.Reader) ([]Record, error) {
+	hdr := make([]byte, 24)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+
+	var (
+		order binary.ByteOrder
+		nano  bool
+	)
+	for _, o := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		switch o.Uint32(hdr) {
+		case pcapMagic:
+			order = o
+		case pcapMagicNano:
+			order, nano = o, true
+		}
+	}
+	if order == nil {
+		return nil, errors.New("rpctrace: not a pcap file")
+	}
+
+	linkType := order.Uint32(hdr[20:])
+	if linkType != linkTypeRaw && linkType != linkTypeEther {
+		return nil, fmt.Errorf("rpctrace: pcap link type %d not supported", linkType)
+	}
+
+	snaplen := order.Uint32(hdr[16:])
+	if snaplen == 0 || snaplen > maxPacketLen {
+		snaplen = maxPacketLen
+	}
+
+	var (
+		records []Record
+		client  []byte
+		parsers = map[Direction]*recordParser{Send: {}, Recv: {}}
+		pkthdr  = make([]byte, 16)
+	)
+	for {
+		if _, err := io.ReadFull(r, pkthdr); err == io.EOF {
+			return records, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		n := order.Uint32(pkthdr[8:])
+		if n > snaplen {
+			return nil, fmt.Errorf("rpctrace: pcap packet of %d bytes exceeds the snapshot length %d", n, snaplen)
+		}
+		pkt := make([]byte, n)
+		if _, err := io.ReadFull(r, pkt); err != nil {
+			return nil, err
+		}
+
+		frac := time.Duration(order.Uint32(pkthdr[4:])) * time.Microsecond
+		if nano {
+			frac = time.Duration(order.Uint32(pkthdr[4:]))
+		}
+		t := time.Unix(int64(order.Uint32(pkthdr[0:])), int64(frac))
+
+		src, payload, ok := tcpPayload(pkt, linkType)
+		if !ok || len(payload) == 0 {
+			continue
+		}
+
+		if client == nil {
+			client = src
+		}
+		dir := Recv
+		if string(src) == string(client) {
+			dir = Send
+		}
+
+		parsers[dir].feed(payload, func(data []byte) {
+			rec := newRecord(dir, data)
+			rec.Time = t
+			records = append(records, *rec)
+		})
+	}
+}
+
+// tcpPayload returns the source address and port of an IPv4 TCP packet, and
+// its payload.
+func tcpPayload(pkt []byte, linkType uint32) ([]byte, []byte, bool) {
+	if linkType == linkTypeEther {
+		if len(pkt) < etherHeaderLen || binary.BigEndian.Uint16(pkt[12:]) != 0x0800 {
+			return nil, nil, false
+		}
+		pkt = pkt[etherHeaderLen:]
+	}
+
+	if len(pkt) < ipHeaderLen || pkt[0]>>4 != 4 || pkt[9] != 6 {
+		return nil, nil, false
+	}
+	ihl := int(pkt[0]&0x0f) * 4
+	total := int(binary.BigEndian.Uint16(pkt[2:]))
+	if ihl < ipHeaderLen || total < ihl || total > len(pkt) {
+		return nil, nil, false
+	}
+
+	tcp := pkt[ihl:total]
+	if len(tcp) < tcpHeaderLen {
+		return nil, nil, false
+	}
+	off := int(tcp[12]>>4) * 4
+	if off < tcpHeaderLen || off > len(tcp) {
+		return nil, nil, false
+	}
+
+	src := append(append([]byte(nil), pkt[12:16]...), tcp[0:2]...)
+	return src, tcp[off:], true
+}
@@ -0,0 +1,201 @@
+package rpctrace
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// ErrReplayExhausted is returned to the client once it sends more calls than
+// the trace holds.
+var ErrReplayExhausted = errors.New("rpctrace: no recorded reply left")
+
+// ReplayConn is a net.Conn that answers the calls written to it with the
+// replies of a recorded trace. Each call is matched to the earliest recorded
+// call not yet replayed with the same program, version, procedure and
+// arguments, so that calls sent concurrently get their own replies whatever
+// order they arrive in. Credentials are not compared, as they change from run
+// to run. The reply gets the XID the client used. A call matching none fails
+// the connection, as a reply to another call would be taken for its own.
+type ReplayConn struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	calls   []Record
+	used    []bool
+	byKey   map[string][]int
+	replies map[uint32][]Record
+	buf     []byte
+	err     error
+	closed  bool
+	parser  recordParser
+}
+
+// NewReplayConn returns a connection that replays records, typically read
+// with ReadJSON or ReadPcap. Records in the Recv direction without a matching
+// Send record are ignored.
+func NewReplayConn(records []Record) *ReplayConn {
+	c := &ReplayConn{
+		byKey:   make(map[string][]int),
+		replies: make(map[uint32][]Record),
+	}
+	c.cond = sync.NewCond(&c.mu)
+
+	for _, r := range records {
+		switch r.Direction {
+		case Send:
+			key := callKey(r.Data)
+			c.byKey[key] = append(c.byKey[key], len(c.calls))
+			c.calls = append(c.calls, r)
+		case Recv:
+			c.replies[r.Xid] = append(c.replies[r.Xid], r)
+		}
+	}
+	c.used = make([]bool, len(c.calls))
+
+	return c
+}
+
+// callKey returns what identifies a call in a trace: its program, version,
+// procedure and arguments, leaving out the XID and the credentials.
+func callKey(call []byte) string {
+	// xid, msg_type, rpcvers, then prog, vers and proc
+	const progOff, credOff = 12, 24
+	if len(call) < credOff {
+		return string(call)
+	}
+
+	off := credOff
+	for i := 0; i < 2; i++ { // cred, verf
+		if len(call) < off+8 {
+			return string(call[progOff:])
+		}
+		n := int(binary.BigEndian.Uint32(call[off+4:]))
+		off += 8 + (n+3)&^3
+	}
+	if off > len(call) {
+		return string(call[progOff:])
+	}
+
+	return string(call[progOff:credOff]) + string(call[off:])
+}
+
+// take returns the recorded call that call is replayed as, or false if none is
+// left. Called with mu held.
+func (c *ReplayConn) take(call []byte) (Record, bool) {
+	key := callKey(call)
+	for len(c.byKey[key]) > 0 {
+		i := c.byKey[key][0]
+		c.byKey[key] = c.byKey[key][1:]
+		if !c.used[i] {
+			c.used[i] = true
+			return c.calls[i], true
+		}
+	}
+
+	return Record{}, false
+}
+
+func (c *ReplayConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return 0, io.ErrClosedPipe
+	}
+
+	c.parser.feed(b, c.reply)
+	c.cond.Broadcast()
+
+	return len(b), nil
+}
+
+// reply queues the recorded reply to call. Called with mu held.
+func (c *ReplayConn) reply(call []byte) {
+	if len(call) < 4 {
+		return
+	}
+
+	rec, ok := c.take(call)
+	if !ok {
+		c.err = c.unmatched(call)
+		return
+	}
+
+	replies := c.replies[rec.Xid]
+	if len(replies) == 0 {
+		// the original call was never answered; neither is this one
+		return
+	}
+	reply := replies[0]
+	c.replies[rec.Xid] = replies[1:]
+
+	data := make([]byte, 4+len(reply.Data))
+	binary.BigEndian.PutUint32(data, uint32(len(reply.Data))|0x80000000)
+	copy(data[4:], reply.Data)
+	if len(reply.Data) >= 4 {
+		copy(data[4:8], call[:4])
+	}
+
+	c.buf = append(c.buf, data...)
+}
+
+// unmatched returns the error for a call that no recorded call is left for.
+// Called with mu held.
+func (c *ReplayConn) unmatched(call []byte) error {
+	left := false
+	for _, used := range c.used {
+		left = left || !used
+	}
+	if !left || len(call) < 24 {
+		return ErrReplayExhausted
+	}
+
+	return fmt.Errorf("rpctrace: no recorded reply for prog %d vers %d proc %d",
+		binary.BigEndian.Uint32(call[12:]), binary.BigEndian.Uint32(call[16:]), binary.BigEndian.Uint32(call[20:]))
+}
+
+func (c *ReplayConn) Read(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.buf) == 0 {
+		if c.closed {
+			return 0, io.EOF
+		}
+		if c.err != nil {
+			return 0, c.err
+		}
+		c.cond.Wait()
+	}
+
+	n := copy(b, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+func (c *ReplayConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+	c.cond.Broadcast()
+	return nil
+}
+
+func (c *ReplayConn) LocalAddr() net.Addr  { return replayAddr("client") }
+func (c *ReplayConn) RemoteAddr() net.Addr { return replayAddr("server") }
+
+// Deadlines are accepted and ignored; replies are always immediately
+// available or never.
+func (c *ReplayConn) SetDeadline(t time.Time) error      { return nil }
+func (c *ReplayConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *ReplayConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type replayAddr string
+
+func (a replayAddr) Network() string { return "replay" }
+func (a replayAddr) String() string  { return string(a) }
@@ -0,0 +1,187 @@
+package rpctrace
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aobco/nfs/nfs3/rpc"
+	"github.com/aobco/nfs/nfs3/xdr"
+)
+
+const (
+	testProg = 0x20000000
+	testVers = 1
+)
+
+type testCall struct {
+	rpc.Header
+	Arg uint32
+}
+
+// double calls the test procedure, which answers twice its argument.
+func double(c *rpc.Client, cred rpc.Auth, arg uint32) (uint32, error) {
+	res, err := c.Call(&testCall{
+		Header: rpc.Header{Rpcvers: 2, Prog: testProg, Vers: testVers, Proc: 1, Cred: cred},
+		Arg:    arg,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var v uint32
+	err = xdr.Read(res, &v)
+	return v, err
+}
+
+// recordTrace runs the calls against a real server, recording them to w.
+func recordTrace(t *testing.T, w Writer, args []uint32) {
+	t.Helper()
+
+	s := rpc.NewServer()
+	s.Register(testProg, testVers, 1, func(call *rpc.Call, args io.Reader) (interface{}, error) {
+		var arg uint32
+		if err := xdr.Read(args, &arg); err != nil {
+			return nil, rpc.ErrGarbageArgs
+		}
+		return 2 * arg, nil
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go s.Serve(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := rpc.NewClient(NewConn(conn, w))
+	defer c.Close()
+
+	for _, arg := range args {
+		if _, err := double(c, rpc.NewAuthUnix("recorder", 0, 0).Auth(), arg); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+type recordList struct {
+	mu      sync.Mutex
+	records []Record
+}
+
+func (l *recordList) WriteRecord(r *Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.records = append(l.records, *r)
+	return nil
+}
+
+type teeWriter []Writer
+
+func (w teeWriter) WriteRecord(r *Record) error {
+	for _, w := range w {
+		if err := w.WriteRecord(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestReplayMatchesConcurrentCalls(t *testing.T) {
+	args := []uint32{1, 2, 3, 4, 5, 6, 7, 8}
+
+	var trace bytes.Buffer
+	recordTrace(t, NewJSONWriter(&trace), args)
+	records, err := ReadJSON(&trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := rpc.NewClient(NewReplayConn(records))
+	defer c.Close()
+
+	// Sent all at once, in reverse, with credentials of their own.
+	var wg sync.WaitGroup
+	for i := len(args) - 1; i >= 0; i-- {
+		wg.Add(1)
+		go func(arg uint32) {
+			defer wg.Done()
+
+			v, err := double(c, rpc.NewAuthUnix("replayer", 1, 1).Auth(), arg)
+			if err != nil || v != 2*arg {
+				t.Errorf("call %d: got %d, %v, want %d", arg, v, err, 2*arg)
+			}
+		}(args[i])
+	}
+	wg.Wait()
+
+	if _, err := double(c, rpc.AuthNull, 1); err == nil {
+		t.Error("call past the end of the trace succeeded")
+	}
+}
+
+func TestReplayRefusesUnrecordedCall(t *testing.T) {
+	var trace recordList
+	recordTrace(t, &trace, []uint32{1, 2})
+
+	c := rpc.NewClient(NewReplayConn(trace.records))
+	defer c.Close()
+
+	_, err := double(c, rpc.AuthNull, 3)
+	if err == nil || !strings.Contains(err.Error(), "no recorded reply for prog 536870912 vers 1 proc 1") {
+		t.Errorf("unrecorded call: got %v, want no recorded reply", err)
+	}
+}
+
+func TestReadPcap(t *testing.T) {
+	var (
+		pcap bytes.Buffer
+		list recordList
+	)
+	recordTrace(t, teeWriter{NewPcapWriter(&pcap), &list}, []uint32{1, 2})
+
+	records, err := ReadPcap(&pcap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != len(list.records) {
+		t.Fatalf("read %d records, want %d", len(records), len(list.records))
+	}
+	for i, r := range records {
+		want := list.records[i]
+		if r.Direction != want.Direction || r.Xid != want.Xid || !bytes.Equal(r.Data, want.Data) {
+			t.Errorf("record %d: %s %x % x, want %s %x % x", i, r.Direction, r.Xid, r.Data, want.Direction, want.Xid, want.Data)
+		}
+	}
+
+	c := rpc.NewClient(NewReplayConn(records))
+	defer c.Close()
+
+	if v, err := double(c, rpc.AuthNull, 2); err != nil || v != 4 {
+		t.Errorf("replaying the pcap: got %d, %v, want 4", v, err)
+	}
+}
+
+func TestReadPcapRejectsOversizedPacket(t *testing.T) {
+	var pcap bytes.Buffer
+	recordTrace(t, NewPcapWriter(&pcap), []uint32{1})
+
+	// The first packet header follows the 24-byte file header; its captured
+	// length is past the 65535-byte snapshot length.
+	data := pcap.Bytes()
+	binary.LittleEndian.PutUint32(data[24+8:], 1<<30)
+
+	if _, err := ReadPcap(bytes.NewReader(data)); err == nil || !strings.Contains(err.Error(), "exceeds the snapshot length") {
+		t.Errorf("got %v, want an error about the snapshot length", err)
+	}
+}
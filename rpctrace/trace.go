@@ -0,0 +1,159 @@
+// Package rpctrace records the RPC records exchanged over a connection and
+// replays them later. It works at the record-marking layer, so it can wrap the
+// connection of both the nfs3 rpc.Client and the nfs4 NfsClient:
+//
+//	conn, _ := net.Dial("tcp", "filer:2049")
+//	client := rpc.NewClient(rpctrace.NewConn(conn, rpctrace.NewJSONWriter(f)))
+//
+// A trace written as JSON or pcap is read back with ReadJSON or ReadPcap, and
+// replayed by a client on a ReplayConn:
+//
+//	records, _ := rpctrace.ReadJSON(f)
+//	client := rpc.NewClient(rpctrace.NewReplayConn(records))
+package rpctrace
+
+import (
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+)
+
+// Direction tells whether a record was sent or received by the client.
+type Direction string
+
+const (
+	Send Direction = "send"
+	Recv Direction = "recv"
+)
+
+// Record is one reassembled RPC record.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Direction Direction `json:"dir"`
+	Xid       uint32    `json:"xid"`
+	Data      []byte    `json:"data"`
+}
+
+// Writer stores records, e.g. as JSON lines or in a pcap file.
+type Writer interface {
+	WriteRecord(r *Record) error
+}
+
+// Conn is a net.Conn that hands a copy of every complete record written to or
+// read from the underlying connection to a Writer.
+type Conn struct {
+	net.Conn
+
+	mu   sync.Mutex
+	w    Writer
+	err  error
+	sent recordParser
+	recv recordParser
+}
+
+// NewConn wraps conn so that its traffic is recorded to w. Failing to record
+// never fails the connection; the first error is kept and returned by Err.
+func NewConn(conn net.Conn, w Writer) *Conn {
+	if aw, ok := w.(interface{ setAddrs(local, remote net.Addr) }); ok {
+		aw.setAddrs(conn.LocalAddr(), conn.RemoteAddr())
+	}
+
+	return &Conn{Conn: conn, w: w}
+}
+
+func (c *Conn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.record(&c.recv, Recv, b[:n])
+	}
+	return n, err
+}
+
+func (c *Conn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.record(&c.sent, Send, b[:n])
+	}
+	return n, err
+}
+
+// Err returns the first error the Writer returned.
+func (c *Conn) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.err
+}
+
+func (c *Conn) record(p *recordParser, dir Direction, b []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p.feed(b, func(data []byte) {
+		if err := c.w.WriteRecord(newRecord(dir, data)); err != nil && c.err == nil {
+			c.err = err
+		}
+	})
+}
+
+func newRecord(dir Direction, data []byte) *Record {
+	r := &Record{
+		Time:      time.Now(),
+		Direction: dir,
+		Data:      data,
+	}
+	if len(data) >= 4 {
+		r.Xid = binary.BigEndian.Uint32(data)
+	}
+	return r
+}
+
+// recordParser reassembles records from a record-marked byte stream that
+// arrives in arbitrary pieces.
+type recordParser struct {
+	hdr    []byte
+	remain int
+	last   bool
+	record []byte
+}
+
+func (p *recordParser) feed(b []byte, emit func(record []byte)) {
+	for len(b) > 0 {
+		if p.remain == 0 && len(p.hdr) < 4 {
+			n := 4 - len(p.hdr)
+			if n > len(b) {
+				n = len(b)
+			}
+			p.hdr = append(p.hdr, b[:n]...)
+			b = b[n:]
+
+			if len(p.hdr) < 4 {
+				return
+			}
+
+			h := binary.BigEndian.Uint32(p.hdr)
+			p.remain = int(h & 0x7fffffff)
+			p.last = h&0x80000000 != 0
+			if p.remain > 0 {
+				continue
+			}
+		}
+
+		n := p.remain
+		if n > len(b) {
+			n = len(b)
+		}
+		p.record = append(p.record, b[:n]...)
+		p.remain -= n
+		b = b[n:]
+
+		if p.remain == 0 {
+			p.hdr = p.hdr[:0]
+			if p.last {
+				emit(p.record)
+				p.record = nil
+			}
+		}
+	}
+}